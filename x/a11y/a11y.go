@@ -0,0 +1,292 @@
+// Package a11y checks x element trees for common accessibility problems, so
+// tests and CI can hold pages to a baseline before they ship.
+package a11y
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+// Severity ranks a finding.
+type Severity int
+
+const (
+	Warning Severity = iota // Likely a problem, but may be intended
+	Error                   // Fails the baseline
+)
+
+func (s Severity) String() string {
+	if s == Error {
+		return "error"
+	}
+	return "warning"
+}
+
+// Finding is a problem reported by Check.
+type Finding struct {
+	Rule     string   // Identifier of the check, such as "img-alt"
+	Severity Severity // How serious the problem is
+	Path     string   // Location of the element, as reported by x.Validate
+	Message  string   // Description of the problem
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s (%s)", f.Severity, f.Path, f.Message, f.Rule)
+}
+
+// Errors returns the findings with Error severity.
+func Errors(findings []Finding) []Finding {
+	var errs []Finding
+	for _, f := range findings {
+		if f.Severity == Error {
+			errs = append(errs, f)
+		}
+	}
+	return errs
+}
+
+// Check inspects the tree and returns its findings in document order:
+//
+//   - img-alt: an img without an alt attribute
+//   - label: a form control without a label, through for and id, nesting,
+//     aria-label, aria-labelledby or title
+//   - button-name, link-name: a button or link without an accessible name
+//   - heading-order: a heading more than one level below the previous one
+//   - html-lang: an html element without a lang attribute
+//   - table-header: a data table without th cells
+//   - aria-attr, aria-value, role: an unknown aria-* attribute, an invalid
+//     value for one, or an unknown role
+//   - aria-role: an aria attribute the role requires is missing, or naming
+//     is prohibited for the role
+//   - aria-hidden-focus: a focusable element hidden with aria-hidden
+//
+// Accessible names are computed from aria-labelledby, aria-label, text,
+// the alt of nested images and title, following the spirit of the
+// accessible name computation without implementing all of it.
+func Check(e x.Elem) []Finding {
+	c := &checker{
+		ids:      make(map[string]x.Elem),
+		labelled: make(map[string]bool),
+	}
+	x.Walk(e, func(el x.Elem, _ string, _ []x.Elem) bool {
+		if id, ok := el.Attr("id"); ok {
+			if _, dup := c.ids[id]; !dup {
+				c.ids[id] = el
+			}
+		}
+		if tag(el) == "label" {
+			if target, ok := el.Attr("for"); ok {
+				c.labelled[target] = true
+			}
+		}
+		return true
+	})
+	x.Walk(e, func(el x.Elem, path string, ancestors []x.Elem) bool {
+		c.check(el, path, ancestors)
+		return true
+	})
+	return c.findings
+}
+
+type checker struct {
+	findings    []Finding
+	ids         map[string]x.Elem
+	labelled    map[string]bool // ids targeted by a label's for attribute
+	lastHeading int
+}
+
+func (c *checker) report(rule string, severity Severity, path, format string, args ...interface{}) {
+	c.findings = append(c.findings, Finding{
+		Rule:     rule,
+		Severity: severity,
+		Path:     path,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func tag(el x.Elem) string {
+	return strings.ToLower(el.Tag)
+}
+
+func attr(el x.Elem, key string) string {
+	v, _ := el.Attr(key)
+	return v
+}
+
+func (c *checker) check(el x.Elem, path string, ancestors []x.Elem) {
+	t := tag(el)
+	role := strings.TrimSpace(attr(el, "role"))
+	presentational := role == "presentation" || role == "none"
+
+	switch t {
+	case "html":
+		if strings.TrimSpace(attr(el, "lang")) == "" {
+			c.report("html-lang", Error, path, "<html> has no lang attribute")
+		}
+	case "img":
+		if _, ok := el.Attr("alt"); !ok {
+			c.report("img-alt", Error, path, "<img> has no alt attribute; use alt=\"\" for decorative images")
+		}
+	case "input":
+		switch strings.ToLower(attr(el, "type")) {
+		case "hidden":
+		case "submit", "reset":
+		case "image":
+			if strings.TrimSpace(attr(el, "alt")) == "" && c.ariaName(el) == "" {
+				c.report("button-name", Error, path, "image button has no alt text")
+			}
+		case "button":
+			if strings.TrimSpace(attr(el, "value")) == "" && c.ariaName(el) == "" {
+				c.report("button-name", Error, path, "button has no accessible name")
+			}
+		default:
+			c.checkLabel(el, path, ancestors)
+		}
+	case "select", "textarea":
+		c.checkLabel(el, path, ancestors)
+	case "button":
+		if c.name(el) == "" {
+			c.report("button-name", Error, path, "button has no accessible name")
+		}
+	case "a":
+		if _, ok := el.Attr("href"); ok && c.name(el) == "" {
+			c.report("link-name", Error, path, "link has no accessible name")
+		}
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		c.checkHeading(int(t[1]-'0'), path)
+	case "table":
+		if !presentational && !hasTag(el, "th") {
+			c.report("table-header", Warning, path, "table has no header cells; use th, or role=\"presentation\" for layout tables")
+		}
+	}
+
+	if role != "" {
+		if t != "button" && t != "input" && strings.Fields(role)[0] == "button" && c.name(el) == "" {
+			c.report("button-name", Error, path, "button has no accessible name")
+		}
+		if level := attr(el, "aria-level"); strings.Fields(role)[0] == "heading" && level != "" {
+			if n, err := strconv.Atoi(level); err == nil {
+				c.checkHeading(n, path)
+			}
+		}
+	}
+	c.checkARIA(el, t, role, path)
+}
+
+// checkLabel reports form controls without a label.
+func (c *checker) checkLabel(el x.Elem, path string, ancestors []x.Elem) {
+	if id := attr(el, "id"); id != "" && c.labelled[id] {
+		return
+	}
+	for _, a := range ancestors {
+		if tag(a) == "label" {
+			return
+		}
+	}
+	if c.ariaName(el) != "" || strings.TrimSpace(attr(el, "title")) != "" {
+		return
+	}
+	c.report("label", Error, path, "<%s> has no label", tag(el))
+}
+
+func (c *checker) checkHeading(level int, path string) {
+	if c.lastHeading > 0 && level > c.lastHeading+1 {
+		c.report("heading-order", Warning, path, "heading level %d follows level %d", level, c.lastHeading)
+	}
+	c.lastHeading = level
+}
+
+// ariaName returns the name given by aria-labelledby or aria-label.
+func (c *checker) ariaName(el x.Elem) string {
+	if ids := attr(el, "aria-labelledby"); ids != "" {
+		var parts []string
+		for _, id := range strings.Fields(ids) {
+			if ref, ok := c.ids[id]; ok {
+				parts = append(parts, c.content(ref.Children))
+			}
+		}
+		if name := strings.TrimSpace(strings.Join(parts, " ")); name != "" {
+			return name
+		}
+	}
+	return strings.TrimSpace(attr(el, "aria-label"))
+}
+
+// name returns the accessible name of a button or link.
+func (c *checker) name(el x.Elem) string {
+	if name := c.ariaName(el); name != "" {
+		return name
+	}
+	if name := strings.TrimSpace(c.content(el.Children)); name != "" {
+		return name
+	}
+	return strings.TrimSpace(attr(el, "title"))
+}
+
+// content returns the name contributed by children: text, the alt of
+// images and the names of nested elements, skipping hidden ones.
+func (c *checker) content(children []x.Elem) string {
+	var b strings.Builder
+	for _, child := range children {
+		switch child.Type {
+		case x.ContentNode:
+			b.WriteString(child.Content)
+		case x.FragmentNode:
+			b.WriteString(c.content(child.Children))
+		case x.TagNode:
+			if attr(child, "aria-hidden") == "true" {
+				continue
+			}
+			if _, hidden := child.Attr("hidden"); hidden {
+				continue
+			}
+			if name := c.ariaName(child); name != "" {
+				b.WriteString(" " + name + " ")
+				continue
+			}
+			switch tag(child) {
+			case "img", "area":
+				b.WriteString(" " + attr(child, "alt") + " ")
+			default:
+				b.WriteString(c.content(child.Children))
+			}
+		}
+	}
+	return b.String()
+}
+
+func hasTag(e x.Elem, name string) bool {
+	found := false
+	x.Walk(e, func(el x.Elem, _ string, _ []x.Elem) bool {
+		if tag(el) == name {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// focusable reports whether el takes keyboard focus.
+func focusable(el x.Elem, t string) bool {
+	if ti, ok := el.Attr("tabindex"); ok {
+		n, err := strconv.Atoi(strings.TrimSpace(ti))
+		return err == nil && n >= 0
+	}
+	if _, disabled := el.Attr("disabled"); disabled {
+		return false
+	}
+	switch t {
+	case "a", "area":
+		_, ok := el.Attr("href")
+		return ok
+	case "button", "select", "textarea", "iframe", "summary":
+		return true
+	case "input":
+		return !strings.EqualFold(attr(el, "type"), "hidden")
+	}
+	_, editable := el.Attr("contenteditable")
+	return editable
+}
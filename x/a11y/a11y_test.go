@@ -0,0 +1,134 @@
+package a11y
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		elem     x.Elem
+		expected []Finding
+	}{
+		{
+			name: "Accessible page",
+			elem: x.Html(x.Att("lang", "en"),
+				x.Head(x.Title(x.C("Home"))),
+				x.Body(
+					x.H1(x.C("Shop")),
+					x.A(x.Att("href", "/"), x.Img(x.Att("src", "logo.png"), x.Att("alt", "Home"))),
+					x.H2(x.C("Search")),
+					x.Form(
+						x.Label(x.Att("for", "q"), x.C("Query")),
+						x.Input(x.Att("id", "q")),
+						x.Label(x.C("Size "), x.E("select")),
+						x.Input(x.Att("type", "hidden"), x.Att("name", "t")),
+						x.E("textarea", x.Att("aria-label", "Notes")),
+						x.Button(x.E("svg", x.Att("aria-hidden", "true")), x.Span(x.C("Go"))),
+						x.Div(x.Att("role", "checkbox"), x.Att("aria-checked", "mixed"), x.Att("tabindex", "0"), x.C("All")),
+					),
+					x.Table(x.Tr(x.Th(x.C("Name"))), x.Tr(x.Td(x.C("Ann")))),
+					x.Table(x.Att("role", "presentation"), x.Tr(x.Td())),
+				),
+			),
+		},
+		{
+			name: "Missing lang and alt",
+			elem: x.Html(x.Body(x.Img(x.Att("src", "a.png")))),
+			expected: []Finding{
+				{Rule: "html-lang", Severity: Error, Path: "html", Message: "<html> has no lang attribute"},
+				{Rule: "img-alt", Severity: Error, Path: "html > body > img", Message: `<img> has no alt attribute; use alt="" for decorative images`},
+			},
+		},
+		{
+			name: "Unlabelled controls",
+			elem: x.Form(
+				x.Label(x.Att("for", "other"), x.C("Other")),
+				x.Input(x.Att("id", "name")),
+				x.E("select"),
+			),
+			expected: []Finding{
+				{Rule: "label", Severity: Error, Path: "form > input#name", Message: "<input> has no label"},
+				{Rule: "label", Severity: Error, Path: "form > select", Message: "<select> has no label"},
+			},
+		},
+		{
+			name: "Nameless buttons and links",
+			elem: x.Div(
+				x.Button(x.E("svg")),
+				x.A(x.Att("href", "/x"), x.Img(x.Att("alt", ""))),
+				x.Span(x.Att("role", "button"), x.Att("tabindex", "0")),
+				x.A(x.C("No href is not a link")),
+			),
+			expected: []Finding{
+				{Rule: "button-name", Severity: Error, Path: "div > button", Message: "button has no accessible name"},
+				{Rule: "link-name", Severity: Error, Path: "div > a[1]", Message: "link has no accessible name"},
+				{Rule: "button-name", Severity: Error, Path: "div > span", Message: "button has no accessible name"},
+			},
+		},
+		{
+			name: "Names from aria-labelledby and title",
+			elem: x.Div(
+				x.Span(x.Att("id", "lbl"), x.C("Close")),
+				x.Button(x.Att("aria-labelledby", "lbl")),
+				x.A(x.Att("href", "/"), x.Att("title", "Home")),
+			),
+		},
+		{
+			name: "Skipped heading level",
+			elem: x.Div(x.H1(x.C("a")), x.H2(x.C("b")), x.E("h4", x.C("c")), x.H2(x.C("d"))),
+			expected: []Finding{
+				{Rule: "heading-order", Severity: Warning, Path: "div > h4", Message: "heading level 4 follows level 2"},
+			},
+		},
+		{
+			name: "Table without headers",
+			elem: x.Table(x.Tr(x.Td(x.C("1")))),
+			expected: []Finding{
+				{Rule: "table-header", Severity: Warning, Path: "table", Message: `table has no header cells; use th, or role="presentation" for layout tables`},
+			},
+		},
+		{
+			name: "Invalid ARIA",
+			elem: x.Div(
+				x.Div(x.Att("role", "buton"), x.C("x")),
+				x.Span(x.Att("aria-lable", "x")),
+				x.Div(x.Att("role", "region"), x.Att("aria-expanded", "yes"), x.Att("aria-controls", "nope")),
+				x.Div(x.Att("role", "slider")),
+				x.Span(x.Att("aria-label", "Generic")),
+				x.Button(x.Att("aria-hidden", "true"), x.C("Hidden")),
+				x.A(x.Att("href", "/"), x.Att("role", "none"), x.C("Home")),
+			),
+			expected: []Finding{
+				{Rule: "role", Severity: Error, Path: "div > div[1]", Message: `unknown role "buton"`},
+				{Rule: "aria-attr", Severity: Error, Path: "div > span[1]", Message: `unknown attribute "aria-lable"`},
+				{Rule: "aria-value", Severity: Error, Path: "div > div[2]", Message: "aria-expanded: value must be one of true, false, undefined"},
+				{Rule: "aria-value", Severity: Error, Path: "div > div[2]", Message: `aria-controls: no element has id "nope"`},
+				{Rule: "aria-role", Severity: Error, Path: "div > div[3]", Message: `role "slider" requires aria-valuenow`},
+				{Rule: "aria-role", Severity: Error, Path: "div > span[2]", Message: `aria-label is not allowed on an element with role "generic"`},
+				{Rule: "aria-hidden-focus", Severity: Error, Path: "div > button", Message: "focusable element is hidden with aria-hidden"},
+				{Rule: "aria-role", Severity: Warning, Path: "div > a", Message: `role "none" is ignored on a focusable element`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Check(tt.elem)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	findings := Check(x.Div(x.H1(), x.E("h3"), x.Img()))
+	errs := Errors(findings)
+	if len(findings) != 2 || len(errs) != 1 || errs[0].Rule != "img-alt" {
+		t.Errorf("expected one warning and one error, got %v", findings)
+	}
+}
@@ -0,0 +1,127 @@
+package a11y
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/zulubit/xxhtml/x"
+	"github.com/zulubit/xxhtml/x/spec"
+)
+
+// nativeState lists elements whose native semantics supply the attributes
+// a role requires, such as the checked state of a checkbox input.
+var nativeState = map[string]bool{
+	"input": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"meter": true, "progress": true, "select": true, "details": true, "summary": true,
+}
+
+func (c *checker) checkARIA(el x.Elem, t, role, path string) {
+	var effective spec.Role
+	if role != "" {
+		name := strings.Fields(role)[0]
+		var ok bool
+		if effective, ok = spec.LookupRole(name); !ok {
+			c.report("role", Error, path, "unknown role %q", name)
+		}
+	} else if t == "div" || t == "span" {
+		effective, _ = spec.LookupRole("generic")
+	}
+
+	present := make(map[string]bool)
+	for _, a := range el.Attrs() {
+		key := strings.ToLower(a.AttrKey)
+		if !strings.HasPrefix(key, "aria-") {
+			continue
+		}
+		present[key] = true
+		def, ok := spec.LookupARIA(key)
+		if !ok {
+			c.report("aria-attr", Error, path, "unknown attribute %q", a.AttrKey)
+			continue
+		}
+		if msg := c.checkValue(def, a.AttrVal); msg != "" {
+			c.report("aria-value", Error, path, "%s: %s", a.AttrKey, msg)
+		}
+	}
+
+	if !nativeState[t] {
+		for _, req := range effective.Required {
+			if !present[req] {
+				c.report("aria-role", Error, path, "role %q requires %s", effective.Name, req)
+			}
+		}
+	}
+	if effective.NamingProhibited {
+		for _, key := range []string{"aria-label", "aria-labelledby"} {
+			if present[key] {
+				c.report("aria-role", Error, path, "%s is not allowed on an element with role %q", key, effective.Name)
+			}
+		}
+	}
+
+	if focusable(el, t) {
+		if attr(el, "aria-hidden") == "true" {
+			c.report("aria-hidden-focus", Error, path, "focusable element is hidden with aria-hidden")
+		}
+		if effective.Name == "presentation" || effective.Name == "none" {
+			c.report("aria-role", Warning, path, "role %q is ignored on a focusable element", effective.Name)
+		}
+	}
+}
+
+// checkValue returns why value is invalid for def, or "".
+func (c *checker) checkValue(def spec.Attribute, value string) string {
+	value = strings.TrimSpace(value)
+	oneOf := func(allowed ...string) string {
+		for _, a := range allowed {
+			if value == a {
+				return ""
+			}
+		}
+		return "value must be one of " + strings.Join(allowed, ", ")
+	}
+	switch def.Type {
+	case spec.TrueFalse:
+		return oneOf("true", "false")
+	case spec.Tristate:
+		return oneOf("true", "false", "mixed")
+	case spec.TrueFalseUndefined:
+		return oneOf("true", "false", "undefined")
+	case spec.Enum:
+		return oneOf(def.Values...)
+	case spec.Tokens:
+		for _, tok := range strings.Fields(value) {
+			if !contains(def.Values, tok) {
+				return "value must be a list of " + strings.Join(def.Values, ", ")
+			}
+		}
+	case spec.Integer:
+		if _, err := strconv.Atoi(value); err != nil {
+			return "value must be an integer"
+		}
+	case spec.Number:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "value must be a number"
+		}
+	case spec.IDRef, spec.IDRefs:
+		ids := strings.Fields(value)
+		if len(ids) == 0 || (def.Type == spec.IDRef && len(ids) > 1) {
+			return "value must reference element ids"
+		}
+		for _, id := range ids {
+			if _, ok := c.ids[id]; !ok {
+				return "no element has id " + strconv.Quote(id)
+			}
+		}
+	}
+	return ""
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
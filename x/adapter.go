@@ -5,61 +5,19 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/zulubit/xxhtml/x/spec"
 	"golang.org/x/net/html"
 	"golang.org/x/net/html/atom"
 )
 
-// map of HTML tag names to corresponding x convenience functions
-var tagToFunc = map[string]string{
-	"div":        "x.Div",
-	"span":       "x.Span",
-	"p":          "x.P",
-	"a":          "x.A",
-	"img":        "x.Img",
-	"h1":         "x.H1",
-	"h2":         "x.H2",
-	"h3":         "x.H3",
-	"ul":         "x.Ul",
-	"ol":         "x.Ol",
-	"li":         "x.Li",
-	"table":      "x.Table",
-	"tr":         "x.Tr",
-	"td":         "x.Td",
-	"th":         "x.Th",
-	"form":       "x.Form",
-	"input":      "x.Input",
-	"button":     "x.Button",
-	"label":      "x.Label",
-	"article":    "x.Article",
-	"aside":      "x.Aside",
-	"header":     "x.Header",
-	"footer":     "x.Footer",
-	"main":       "x.Main",
-	"section":    "x.Section",
-	"nav":        "x.Nav",
-	"figure":     "x.Figure",
-	"figcaption": "x.Figcaption",
-	"datalist":   "x.Datalist",
-	"option":     "x.Option",
-	"details":    "x.Details",
-	"summary":    "x.Summary",
-	"dialog":     "x.Dialog",
-	"embed":      "x.Embed",
-	"map":        "x.Map",
-	"area":       "x.Area",
-	"source":     "x.Source",
-	"track":      "x.Track",
-	"param":      "x.Param",
-	"script":     "x.Script",
-	"style":      "x.Style",
-	"meta":       "x.Meta",
-	"link":       "x.Link",
-	"title":      "x.Title",
-	"base":       "x.Base",
-	"html":       "x.Html",
-	"!doctype":   "x.DOCTYPE", // Special handling for DOCTYPE
-	"head":       "x.Head",
-	"body":       "x.Body",
+// constructorFor returns the x function that creates an element with the
+// given tag, such as "x.Div". Every element in package spec has one.
+func constructorFor(tag string) (string, bool) {
+	el, ok := spec.Lookup(tag)
+	if !ok {
+		return "", false
+	}
+	return "x." + el.GoName(), true
 }
 
 // ConvertNode converts an HTML node into a custom Go syntax using the x package.
@@ -82,7 +40,7 @@ func ConvertNode(n *html.Node) string {
 
 	elem := ""
 	// Check if the tag has a corresponding convenience function
-	elemFunc, exists := tagToFunc[n.Data]
+	elemFunc, exists := constructorFor(n.Data)
 	if !exists {
 		elemFunc = "x.E" // fallback to generic E() function
 		elem = fmt.Sprintf(`%s("%s",`, elemFunc, n.Data)
@@ -0,0 +1,1370 @@
+package x
+
+import (
+	"cmp"
+	"fmt"
+	"go/format"
+	"go/token"
+	htmltemplate "html/template"
+	"path"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template/parse"
+	"unicode"
+
+	"github.com/zulubit/xxhtml/x/spec"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// TemplateOptions configures ConvertTemplate.
+type TemplateOptions struct {
+	Package  string      // Package clause of the generated file; defaults to "views"
+	DataType string      // Go type of the data parameter; defaults to the type of Data, or "any"
+	Data     interface{} // Optional sample value used to resolve field types
+}
+
+// ConvertTemplate converts an html/template source into Go source using the x package.
+// The named template and every template it defines become functions taking a
+// typed data parameter. Actions become x.C calls, {{if}} becomes x.IF or x.TER,
+// {{range}} and {{with}} become loops and scoped variables, and {{template}}
+// becomes a call to the generated function. Constructs that cannot be
+// translated are left as TODO comments in the output.
+//
+// html/template escapes values by context, which x does not. Dynamic
+// values in URL attributes such as href are wrapped with SafeURL and those
+// in style attributes with EscapeCSSValue. Values in event handler
+// attributes and actions inside script and style get TODO comments, as they
+// must be escaped for JavaScript or CSS by hand.
+func ConvertTemplate(name, text string, opts TemplateOptions) (string, error) {
+	trees := make(map[string]*parse.Tree)
+	t := parse.New(name)
+	t.Mode = parse.SkipFuncCheck
+	if _, err := t.Parse(text, "", "", trees); err != nil {
+		return "", fmt.Errorf("error parsing template: %w", err)
+	}
+
+	c := &tmplConverter{
+		trees:   trees,
+		types:   make(map[string]reflect.Type),
+		imports: make(map[string]bool),
+		funcs:   make(map[string]bool),
+	}
+
+	pkg := opts.Package
+	if pkg == "" {
+		pkg = "views"
+	}
+	var dataType reflect.Type
+	if opts.Data != nil {
+		dataType = reflect.TypeOf(opts.Data)
+	}
+	typeName := opts.DataType
+	if typeName == "" {
+		typeName = goTypeName(dataType)
+	}
+
+	// Convert the named template first so that calls to defined templates
+	// can report the type of the data passed to them.
+	var order []string
+	if _, ok := trees[name]; ok {
+		order = append(order, name)
+		c.types[name] = dataType
+	}
+	var defined []string
+	for n := range trees {
+		if n != name {
+			defined = append(defined, n)
+		}
+	}
+	sort.Strings(defined)
+	order = append(order, defined...)
+
+	var funcs []string
+	done := make(map[string]bool)
+	for len(order) > 0 {
+		n := order[0]
+		order = order[1:]
+		if done[n] {
+			continue
+		}
+		done[n] = true
+
+		paramType := typeName
+		if n != name {
+			paramType = goTypeName(c.types[n])
+		}
+		src, err := c.convertTree(n, trees[n], paramType, c.types[n])
+		if err != nil {
+			return "", err
+		}
+		funcs = append(funcs, src)
+
+		// Templates called from this one are converted next, now that the
+		// type of their data is known.
+		for _, called := range c.called {
+			if !done[called] {
+				order = append([]string{called}, order...)
+			}
+		}
+		c.called = nil
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "// Converted from %s by x.ConvertTemplate.\n\n", name)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	b.WriteString("import (\n")
+	var imports []string
+	for imp := range c.imports {
+		imports = append(imports, imp)
+	}
+	sort.Strings(imports)
+	for _, imp := range imports {
+		fmt.Fprintf(&b, "%q\n", imp)
+	}
+	b.WriteString("\n\"github.com/zulubit/xxhtml/x\"\n)\n\n")
+	if len(c.funcs) > 0 {
+		var names []string
+		for f := range c.funcs {
+			names = append(names, f)
+		}
+		sort.Strings(names)
+		fmt.Fprintf(&b, "// TODO: provide the template functions used below: %s.\n\n", strings.Join(names, ", "))
+	}
+	b.WriteString(strings.Join(funcs, "\n"))
+
+	out, err := format.Source([]byte(b.String()))
+	if err != nil {
+		return b.String(), fmt.Errorf("error formatting generated code: %w", err)
+	}
+	return string(out), nil
+}
+
+// Truthy reports whether value is non-empty, using the same rules as the
+// {{if}} action of html/template. ConvertTemplate uses it for conditions
+// on values whose type it cannot resolve.
+func Truthy(value interface{}) bool {
+	truth, _ := htmltemplate.IsTrue(value)
+	return truth
+}
+
+// SortedKeys returns the keys of m in increasing order. ConvertTemplate
+// ranges over maps with it, since html/template visits map entries in key
+// order.
+func SortedKeys[M ~map[K]V, K cmp.Ordered, V any](m M) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// SafeURL returns u if it is relative or uses the http, https or mailto
+// scheme, and "#ZgotmplZ" otherwise, as html/template does for URLs built
+// from data, so that javascript: URLs cannot be injected. ConvertTemplate
+// wraps the dynamic values of URL attributes with it.
+func SafeURL(u string) string {
+	if i := strings.IndexAny(u, ":/?#"); i >= 0 && u[i] == ':' {
+		switch strings.ToLower(u[:i]) {
+		case "http", "https", "mailto":
+		default:
+			return "#ZgotmplZ"
+		}
+	}
+	return u
+}
+
+// tmplConverter holds the state of a single ConvertTemplate call.
+type tmplConverter struct {
+	trees   map[string]*parse.Tree
+	types   map[string]reflect.Type // data type passed to each template, when known
+	called  []string                // templates called from the tree being converted
+	imports map[string]bool
+	funcs   map[string]bool // custom template functions referenced
+
+	holes  []tmplHole
+	blocks []tmplBlock
+	lex    htmlLexState
+	names  map[string]bool // Go identifiers used in the current function
+}
+
+// tmplExpr is a translated template expression.
+type tmplExpr struct {
+	code   string
+	typ    reflect.Type // nil when the type is unknown
+	isBool bool
+}
+
+// tmplScope tracks the meaning of dot and of template variables.
+type tmplScope struct {
+	dot  tmplExpr
+	vars map[string]tmplExpr
+}
+
+func (s *tmplScope) with(dot tmplExpr) *tmplScope {
+	vars := make(map[string]tmplExpr, len(s.vars))
+	for k, v := range s.vars {
+		vars[k] = v
+	}
+	return &tmplScope{dot: dot, vars: vars}
+}
+
+// tmplHole is an action replaced by a placeholder token in the HTML stream.
+type tmplHole struct {
+	elem   string // code in element content, or "" if unsupported there
+	str    string // string-valued code for attribute values, or "" if unsupported
+	strFmt bool   // str calls fmt.Sprint
+	attr   string // code in attribute position, or "" if unsupported
+	todo   string // description used when the hole cannot be translated
+	source string // original action
+}
+
+// tmplBlock is an {{if}}, {{range}} or {{with}} action spanning element content,
+// delimited in the HTML stream by comment markers.
+type tmplBlock struct {
+	kind     string // "if", "range" or "with"
+	cond     string // condition (if, with)
+	expr     string // ranged or scoped expression (range, with)
+	key, val string // loop variables (range) or the scoped variable (with)
+	mapVar   string // variable holding a ranged map, ranged in key order
+	todo     string // note on a construct kept with a changed behaviour
+	source   string // original action, for TODO comments
+}
+
+var (
+	tmplHoleRe   = regexp.MustCompile(`__xtpl_(\d+)__`)
+	tmplMarkerRe = regexp.MustCompile(`^xtpl:(open|else|close):(\d+)$`)
+	// tmplTextMarkerRe finds block markers left as text by elements whose
+	// content is not parsed as HTML, such as title and script.
+	tmplTextMarkerRe = regexp.MustCompile(`<!--xtpl:(open|else|close):(\d+)-->`)
+)
+
+func (c *tmplConverter) convertTree(name string, tree *parse.Tree, paramType string, dataType reflect.Type) (string, error) {
+	c.holes = nil
+	c.blocks = nil
+	c.lex = htmlLexState{}
+	c.names = map[string]bool{"data": true}
+
+	root := tmplExpr{code: "data", typ: dataType}
+	scope := &tmplScope{dot: root, vars: map[string]tmplExpr{"$": root}}
+
+	var sb strings.Builder
+	c.emitList(&sb, tree.Root, scope)
+	src := sb.String()
+
+	nodes, err := parseTemplateHTML(src)
+	if err != nil {
+		return "", err
+	}
+	lines := c.convertSiblings(nodes)
+
+	var b strings.Builder
+	fn := tmplFuncName(name)
+	fmt.Fprintf(&b, "// %s renders the %s template.\n", fn, name)
+	if paramType == "any" {
+		b.WriteString("// TODO: replace any with the type of the template data.\n")
+	}
+	fmt.Fprintf(&b, "func %s(data %s) x.Elem {\nreturn %s\n}\n", fn, paramType, fragmentCode(lines))
+	return b.String(), nil
+}
+
+// emitList writes the HTML of a template list, replacing actions with
+// placeholder tokens and comment markers.
+func (c *tmplConverter) emitList(sb *strings.Builder, list *parse.ListNode, scope *tmplScope) {
+	if list == nil {
+		return
+	}
+	for _, node := range list.Nodes {
+		switch n := node.(type) {
+		case *parse.TextNode:
+			sb.Write(n.Text)
+			c.lex.feed(string(n.Text))
+		case *parse.CommentNode:
+		case *parse.ActionNode, *parse.TemplateNode, *parse.IfNode, *parse.RangeNode, *parse.WithNode:
+			if c.lex.comment {
+				// html/template drops HTML comments with the actions in them.
+				c.hole(sb, node, tmplHole{todo: tmplSource(node) + " inside an HTML comment is dropped, as html/template does"})
+				continue
+			}
+			c.emitAction(sb, node, scope)
+		default:
+			c.hole(sb, node, tmplHole{todo: node.String()})
+		}
+	}
+}
+
+// emitAction writes an action found outside HTML comments.
+func (c *tmplConverter) emitAction(sb *strings.Builder, node parse.Node, scope *tmplScope) {
+	switch n := node.(type) {
+	case *parse.ActionNode:
+		if len(n.Pipe.Decl) > 0 {
+			if n.Pipe.IsAssign {
+				c.hole(sb, node, tmplHole{todo: "variable assignment " + n.String()})
+				return
+			}
+			// Declared variables are substituted by their expression.
+			scope.vars[n.Pipe.Decl[0].Ident[0]] = c.pipe(n.Pipe, scope)
+			return
+		}
+		e := c.pipe(n.Pipe, scope)
+		h := tmplHole{todo: "dynamic attributes " + n.String()}
+		h.str, h.strFmt = stringCode(e)
+		if e.typ == reflect.TypeOf(htmltemplate.HTML("")) {
+			h.elem = "x.CR(" + e.code + ")"
+		} else {
+			h.elem = "x.C(" + e.code + ")"
+		}
+		c.hole(sb, node, h)
+	case *parse.TemplateNode:
+		call := c.templateCall(n, scope)
+		c.hole(sb, node, tmplHole{elem: call, todo: n.String() + " inside a tag"})
+	default:
+		if c.lex.inTag() {
+			c.hole(sb, node, c.tagBlock(node, scope))
+			return
+		}
+		c.emitBlock(sb, node, scope)
+	}
+}
+
+func (c *tmplConverter) hole(sb *strings.Builder, node parse.Node, h tmplHole) {
+	h.source = node.String()
+	fmt.Fprintf(sb, "__xtpl_%d__", len(c.holes))
+	c.holes = append(c.holes, h)
+}
+
+// emitBlock writes a block action in element content as comment markers
+// around its branches.
+func (c *tmplConverter) emitBlock(sb *strings.Builder, node parse.Node, scope *tmplScope) {
+	id := len(c.blocks)
+	c.blocks = append(c.blocks, tmplBlock{})
+	block := tmplBlock{source: tmplSource(node)}
+
+	var branch *parse.BranchNode
+	inner := scope
+	switch n := node.(type) {
+	case *parse.IfNode:
+		branch = &n.BranchNode
+		block.kind = "if"
+		block.cond = c.cond(c.pipe(n.Pipe, scope))
+	case *parse.RangeNode:
+		branch = &n.BranchNode
+		block.kind = "range"
+		e := c.pipe(n.Pipe, scope)
+		block.expr = e.code
+		keyType, elemType := rangeTypes(e.typ)
+		block.key, block.val = "_", ""
+		switch len(n.Pipe.Decl) {
+		case 0:
+			block.val = c.ident(singular(lastField(n.Pipe)), "item")
+			inner = scope.with(tmplExpr{code: block.val, typ: elemType})
+		case 1:
+			block.val = c.ident(n.Pipe.Decl[0].Ident[0], "item")
+			inner = scope.with(tmplExpr{code: block.val, typ: elemType})
+			inner.vars[n.Pipe.Decl[0].Ident[0]] = inner.dot
+		default:
+			block.key = c.ident(n.Pipe.Decl[0].Ident[0], "i")
+			block.val = c.ident(n.Pipe.Decl[1].Ident[0], "item")
+			inner = scope.with(tmplExpr{code: block.val, typ: elemType})
+			inner.vars[n.Pipe.Decl[0].Ident[0]] = tmplExpr{code: block.key, typ: keyType}
+			inner.vars[n.Pipe.Decl[1].Ident[0]] = inner.dot
+		}
+		// html/template ranges over maps in key order, where Go's order is
+		// random.
+		if e.typ != nil && e.typ.Kind() == reflect.Map {
+			if orderedKind(e.typ.Key().Kind()) {
+				block.mapVar = c.ident("m", "m")
+				if block.key == "_" {
+					block.key = c.ident("key", "key")
+				}
+			} else {
+				block.todo = "html/template ranges over this map in sorted key order; sort the keys by hand"
+			}
+		}
+	case *parse.WithNode:
+		branch = &n.BranchNode
+		block.kind = "with"
+		e := c.pipe(n.Pipe, scope)
+		name := lastField(n.Pipe)
+		if len(n.Pipe.Decl) > 0 {
+			name = n.Pipe.Decl[0].Ident[0]
+		}
+		block.val = c.ident(name, "v")
+		block.expr = e.code
+		block.cond = c.cond(tmplExpr{code: block.val, typ: e.typ})
+		inner = scope.with(tmplExpr{code: block.val, typ: e.typ})
+		if len(n.Pipe.Decl) > 0 {
+			inner.vars[n.Pipe.Decl[0].Ident[0]] = inner.dot
+		}
+	}
+	c.blocks[id] = block
+
+	fmt.Fprintf(sb, "<!--xtpl:open:%d-->", id)
+	c.emitList(sb, branch.List, inner)
+	if branch.ElseList != nil {
+		fmt.Fprintf(sb, "<!--xtpl:else:%d-->", id)
+		c.emitList(sb, branch.ElseList, scope)
+	}
+	fmt.Fprintf(sb, "<!--xtpl:close:%d-->", id)
+}
+
+// tagBlock translates a block action found inside a tag. In attribute values
+// it becomes a string expression; in attribute position an {{if}} whose
+// branches are plain attribute text becomes a conditional attribute.
+func (c *tmplConverter) tagBlock(node parse.Node, scope *tmplScope) tmplHole {
+	h := tmplHole{todo: tmplSource(node) + " inside a tag"}
+	n, ok := node.(*parse.IfNode)
+	if !ok {
+		return h
+	}
+	cond := c.cond(c.pipe(n.Pipe, scope))
+	then, fmt1, ok1 := c.stringList(n.List, scope)
+	els, fmt2, ok2 := c.stringList(n.ElseList, scope)
+	if ok1 && ok2 {
+		h.strFmt = fmt1 || fmt2
+		if n.ElseList == nil {
+			h.str = fmt.Sprintf("x.SIF(%s, %s)", cond, then)
+		} else {
+			h.str = fmt.Sprintf("x.STER(%s, %s, %s)", cond, then, els)
+		}
+	}
+	thenAttrs, ok1 := attrList(n.List)
+	elseAttrs, ok2 := attrList(n.ElseList)
+	if ok1 && ok2 {
+		if n.ElseList == nil {
+			h.attr = fmt.Sprintf("x.IF(%s, %s)", cond, fragmentCode(thenAttrs))
+		} else {
+			h.attr = fmt.Sprintf("x.TER(%s, %s, %s)", cond, fragmentCode(thenAttrs), fragmentCode(elseAttrs))
+		}
+	}
+	return h
+}
+
+// stringList translates a list of text, actions and {{if}} blocks into a
+// string expression, reporting whether it calls fmt.Sprint.
+func (c *tmplConverter) stringList(list *parse.ListNode, scope *tmplScope) (string, bool, bool) {
+	if list == nil {
+		return `""`, false, true
+	}
+	var parts []string
+	usesFmt := false
+	for _, node := range list.Nodes {
+		switch n := node.(type) {
+		case *parse.TextNode:
+			parts = append(parts, strconv.Quote(string(n.Text)))
+		case *parse.ActionNode:
+			if len(n.Pipe.Decl) > 0 {
+				return "", false, false
+			}
+			code, f := stringCode(c.pipe(n.Pipe, scope))
+			parts = append(parts, code)
+			usesFmt = usesFmt || f
+		case *parse.IfNode:
+			h := c.tagBlock(n, scope)
+			if h.str == "" {
+				return "", false, false
+			}
+			parts = append(parts, h.str)
+			usesFmt = usesFmt || h.strFmt
+		case *parse.CommentNode:
+		default:
+			return "", false, false
+		}
+	}
+	if len(parts) == 0 {
+		return `""`, false, true
+	}
+	return strings.Join(parts, " + "), usesFmt, true
+}
+
+// attrList parses a list of plain text as attributes.
+func attrList(list *parse.ListNode) ([]string, bool) {
+	if list == nil {
+		return nil, true
+	}
+	var text strings.Builder
+	for _, node := range list.Nodes {
+		n, ok := node.(*parse.TextNode)
+		if !ok {
+			return nil, false
+		}
+		text.Write(n.Text)
+	}
+	z := html.NewTokenizer(strings.NewReader("<x " + text.String() + ">"))
+	if z.Next() != html.StartTagToken {
+		return nil, false
+	}
+	var attrs []string
+	for _, a := range z.Token().Attr {
+		attrs = append(attrs, attrCode(a.Key, strconv.Quote(a.Val)))
+	}
+	return attrs, len(attrs) > 0
+}
+
+// templateCall translates {{template "name" pipeline}} into a function call.
+func (c *tmplConverter) templateCall(n *parse.TemplateNode, scope *tmplScope) string {
+	arg := tmplExpr{code: "nil"}
+	if n.Pipe != nil {
+		arg = c.pipe(n.Pipe, scope)
+	}
+	if _, ok := c.trees[n.Name]; ok {
+		if _, seen := c.types[n.Name]; !seen {
+			c.types[n.Name] = arg.typ
+		}
+		c.called = append(c.called, n.Name)
+	}
+	return fmt.Sprintf("%s(%s)", tmplFuncName(n.Name), arg.code)
+}
+
+// pipe translates a pipeline into a Go expression.
+func (c *tmplConverter) pipe(p *parse.PipeNode, scope *tmplScope) tmplExpr {
+	var result *tmplExpr
+	for _, cmd := range p.Cmds {
+		e := c.command(cmd, scope, result)
+		result = &e
+	}
+	if result == nil {
+		return tmplExpr{code: "nil"}
+	}
+	return *result
+}
+
+// command translates a single command. prev is the result of the preceding
+// command in the pipeline, passed as the final argument.
+func (c *tmplConverter) command(cmd *parse.CommandNode, scope *tmplScope, prev *tmplExpr) tmplExpr {
+	var args []tmplExpr
+	for _, a := range cmd.Args[1:] {
+		args = append(args, c.arg(a, scope))
+	}
+	if prev != nil {
+		args = append(args, *prev)
+	}
+
+	switch first := cmd.Args[0].(type) {
+	case *parse.IdentifierNode:
+		return c.call(first.Ident, args)
+	case *parse.FieldNode:
+		if len(args) > 0 {
+			e := c.fields(scope.dot, first.Ident[:len(first.Ident)-1])
+			return tmplExpr{code: fmt.Sprintf("%s.%s(%s)", e.code, first.Ident[len(first.Ident)-1], joinCode(args))}
+		}
+	}
+	return c.arg(cmd.Args[0], scope)
+}
+
+// arg translates a single operand.
+func (c *tmplConverter) arg(node parse.Node, scope *tmplScope) tmplExpr {
+	switch n := node.(type) {
+	case *parse.DotNode:
+		return scope.dot
+	case *parse.FieldNode:
+		return c.fields(scope.dot, n.Ident)
+	case *parse.VariableNode:
+		v, ok := scope.vars[n.Ident[0]]
+		if !ok {
+			v = tmplExpr{code: strings.TrimPrefix(n.Ident[0], "$")}
+		}
+		return c.fields(v, n.Ident[1:])
+	case *parse.ChainNode:
+		return c.fields(c.arg(n.Node, scope), n.Field)
+	case *parse.PipeNode:
+		e := c.pipe(n, scope)
+		e.code = "(" + e.code + ")"
+		return e
+	case *parse.IdentifierNode:
+		return c.call(n.Ident, nil)
+	case *parse.StringNode:
+		return tmplExpr{code: strconv.Quote(n.Text), typ: reflect.TypeOf("")}
+	case *parse.NumberNode:
+		switch {
+		case n.IsInt:
+			return tmplExpr{code: n.Text, typ: reflect.TypeOf(0)}
+		case n.IsFloat:
+			return tmplExpr{code: n.Text, typ: reflect.TypeOf(0.0)}
+		}
+		return tmplExpr{code: n.Text}
+	case *parse.BoolNode:
+		return tmplExpr{code: strconv.FormatBool(n.True), typ: reflect.TypeOf(true), isBool: true}
+	case *parse.NilNode:
+		return tmplExpr{code: "nil"}
+	}
+	return tmplExpr{code: "nil /* TODO: " + todoText(node.String()) + " */"}
+}
+
+// fields resolves a chain of field names against e, using its type when known
+// to choose between struct fields, methods and map keys.
+func (c *tmplConverter) fields(e tmplExpr, names []string) tmplExpr {
+	for _, name := range names {
+		t := e.typ
+		for t != nil && t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		switch {
+		case t == nil:
+			e = tmplExpr{code: e.code + "." + name}
+		case t.Kind() == reflect.Map:
+			e = tmplExpr{code: fmt.Sprintf("%s[%q]", e.code, name), typ: t.Elem()}
+		case t.Kind() == reflect.Struct:
+			if f, ok := t.FieldByName(name); ok {
+				e = tmplExpr{code: e.code + "." + name, typ: f.Type}
+				break
+			}
+			e = c.method(e, name)
+		default:
+			e = c.method(e, name)
+		}
+	}
+	return e
+}
+
+func (c *tmplConverter) method(e tmplExpr, name string) tmplExpr {
+	if e.typ != nil {
+		if m, ok := e.typ.MethodByName(name); ok && m.Type.NumOut() > 0 {
+			return tmplExpr{code: e.code + "." + name + "()", typ: m.Type.Out(0)}
+		}
+		if e.typ.Kind() != reflect.Pointer {
+			if m, ok := reflect.PointerTo(e.typ).MethodByName(name); ok && m.Type.NumOut() > 0 {
+				return tmplExpr{code: e.code + "." + name + "()", typ: m.Type.Out(0)}
+			}
+		}
+	}
+	return tmplExpr{code: e.code + "." + name}
+}
+
+// call translates a call to a builtin or custom template function.
+func (c *tmplConverter) call(name string, args []tmplExpr) tmplExpr {
+	boolType := reflect.TypeOf(true)
+	binary := map[string]string{"eq": "==", "ne": "!=", "lt": "<", "le": "<=", "gt": ">", "ge": ">="}
+	switch name {
+	case "and", "or":
+		op := " && "
+		if name == "or" {
+			op = " || "
+		}
+		var conds []string
+		for _, a := range args {
+			conds = append(conds, c.cond(a))
+		}
+		return tmplExpr{code: "(" + strings.Join(conds, op) + ")", typ: boolType, isBool: true}
+	case "not":
+		if len(args) == 1 {
+			return tmplExpr{code: "!(" + c.cond(args[0]) + ")", typ: boolType, isBool: true}
+		}
+	case "eq":
+		if len(args) > 2 {
+			var conds []string
+			for _, a := range args[1:] {
+				conds = append(conds, args[0].code+" == "+a.code)
+			}
+			return tmplExpr{code: "(" + strings.Join(conds, " || ") + ")", typ: boolType, isBool: true}
+		}
+		fallthrough
+	case "ne", "lt", "le", "gt", "ge":
+		if len(args) == 2 {
+			return tmplExpr{code: fmt.Sprintf("%s %s %s", args[0].code, binary[name], args[1].code), typ: boolType, isBool: true}
+		}
+	case "len":
+		if len(args) == 1 {
+			return tmplExpr{code: "len(" + args[0].code + ")", typ: reflect.TypeOf(0)}
+		}
+	case "index":
+		if len(args) > 0 {
+			e := args[0]
+			for _, k := range args[1:] {
+				var elem reflect.Type
+				if e.typ != nil && (e.typ.Kind() == reflect.Map || e.typ.Kind() == reflect.Slice || e.typ.Kind() == reflect.Array) {
+					elem = e.typ.Elem()
+				}
+				e = tmplExpr{code: e.code + "[" + k.code + "]", typ: elem}
+			}
+			return e
+		}
+	case "slice":
+		if len(args) >= 2 && len(args) <= 4 {
+			var idx []string
+			for _, a := range args[1:] {
+				idx = append(idx, a.code)
+			}
+			if len(idx) == 1 {
+				idx = append(idx, "")
+			}
+			return tmplExpr{code: args[0].code + "[" + strings.Join(idx, ":") + "]", typ: args[0].typ}
+		}
+	case "print", "printf", "println":
+		c.imports["fmt"] = true
+		fn := map[string]string{"print": "Sprint", "printf": "Sprintf", "println": "Sprintln"}[name]
+		return tmplExpr{code: "fmt." + fn + "(" + joinCode(args) + ")", typ: reflect.TypeOf("")}
+	case "html":
+		// Content is escaped by x.C, so the html escaper is not needed.
+		if len(args) == 1 {
+			return args[0]
+		}
+		c.imports["fmt"] = true
+		return tmplExpr{code: "fmt.Sprint(" + joinCode(args) + ")", typ: reflect.TypeOf("")}
+	case "urlquery":
+		c.imports["fmt"] = true
+		c.imports["net/url"] = true
+		return tmplExpr{code: "url.QueryEscape(fmt.Sprint(" + joinCode(args) + "))", typ: reflect.TypeOf("")}
+	case "call":
+		if len(args) > 0 {
+			return tmplExpr{code: args[0].code + "(" + joinCode(args[1:]) + ")"}
+		}
+	case "js":
+		return tmplExpr{code: "nil /* TODO: js escaping of " + todoText(joinCode(args)) + " */"}
+	default:
+		c.funcs[name] = true
+		return tmplExpr{code: name + "(" + joinCode(args) + ")"}
+	}
+	return tmplExpr{code: "nil /* TODO: " + name + " with " + strconv.Itoa(len(args)) + " arguments */"}
+}
+
+// cond translates e into a boolean condition following template truthiness.
+func (c *tmplConverter) cond(e tmplExpr) string {
+	if e.isBool {
+		return e.code
+	}
+	if e.typ == nil {
+		return "x.Truthy(" + e.code + ")"
+	}
+	switch e.typ.Kind() {
+	case reflect.Bool:
+		return e.code
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array, reflect.Chan:
+		return "len(" + e.code + ") > 0"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return e.code + " != 0"
+	case reflect.Pointer, reflect.Interface, reflect.Func:
+		return e.code + " != nil"
+	case reflect.Struct:
+		return "true"
+	}
+	return "x.Truthy(" + e.code + ")"
+}
+
+// stringCode translates e into a string-valued expression, reporting
+// whether it calls fmt.Sprint. The caller imports fmt once the code is
+// used.
+func stringCode(e tmplExpr) (string, bool) {
+	if e.typ != nil && e.typ.Kind() == reflect.String {
+		if e.typ.Name() == "string" {
+			return e.code, false
+		}
+		return "string(" + e.code + ")", false
+	}
+	return "fmt.Sprint(" + e.code + ")", true
+}
+
+// ident returns an unused Go identifier derived from a template name.
+func (c *tmplConverter) ident(name, fallback string) string {
+	name = strings.TrimPrefix(name, "$")
+	if name == "" || !token.IsIdentifier(name) {
+		name = fallback
+	}
+	name = strings.ToLower(name[:1]) + name[1:]
+	base := name
+	for i := 2; c.names[name] || token.IsKeyword(name) || tmplReserved[name]; i++ {
+		name = base + strconv.Itoa(i)
+	}
+	c.names[name] = true
+	return name
+}
+
+// tmplReserved holds identifiers that generated code must not shadow.
+var tmplReserved = map[string]bool{
+	"x": true, "fmt": true, "url": true, "items": true, "len": true, "nil": true,
+	"true": true, "false": true, "string": true, "any": true,
+}
+
+// convertNode converts a parsed HTML node into lines of Go code.
+func (c *tmplConverter) convertNode(n *html.Node) []string {
+	switch n.Type {
+	case html.DocumentNode:
+		return c.convertChildren(n)
+	case html.DoctypeNode:
+		return []string{"x.DOCTYPE(),"}
+	case html.CommentNode:
+		if m := tmplMarkerRe.FindStringSubmatch(n.Data); m != nil {
+			id, _ := strconv.Atoi(m[2])
+			return []string{"// TODO: " + todoText(c.blocks[id].source) + " spans element boundaries"}
+		}
+		var lines []string
+		for _, m := range tmplHoleRe.FindAllStringSubmatch(n.Data, -1) {
+			id, _ := strconv.Atoi(m[1])
+			lines = append(lines, "// TODO: "+todoText(c.holes[id].todo))
+		}
+		return lines
+	case html.TextNode:
+		return c.convertText(n)
+	case html.ElementNode:
+		return []string{c.convertElement(n)}
+	}
+	return nil
+}
+
+func (c *tmplConverter) convertText(n *html.Node) []string {
+	text := trimIndent(n.Data)
+	if text == "" {
+		return nil
+	}
+	if n.Parent != nil && n.Parent.Type == html.ElementNode {
+		el, _ := spec.Lookup(n.Parent.Data)
+		switch el.Text {
+		case spec.RawText:
+			// The content of script and style is JS or CSS, which the
+			// actions in it would need escaping for.
+			if tmplHoleRe.MatchString(text) || tmplTextMarkerRe.MatchString(text) {
+				return []string{
+					"// TODO: html/template escaped the actions inside <" + n.Parent.Data + "> for its JS or CSS context; convert them by hand",
+					"x.CR(" + strconv.Quote(c.templateText(text)) + "),",
+				}
+			}
+			return []string{"x.CR(" + strconv.Quote(text) + "),"}
+		case spec.EscapableRawText:
+			// Comments are text in title and textarea, so block markers
+			// arrive as text and are reassembled here.
+			if tmplTextMarkerRe.MatchString(text) {
+				return c.convertSiblings(splitMarkers(text))
+			}
+		}
+	}
+	var lines []string
+	last := 0
+	for _, m := range tmplHoleRe.FindAllStringSubmatchIndex(text, -1) {
+		if m[0] > last {
+			lines = append(lines, "x.C("+strconv.Quote(text[last:m[0]])+"),")
+		}
+		id, _ := strconv.Atoi(text[m[2]:m[3]])
+		if h := c.holes[id]; h.elem != "" {
+			lines = append(lines, h.elem+",")
+		} else {
+			lines = append(lines, "// TODO: "+todoText(h.todo))
+		}
+		last = m[1]
+	}
+	if last < len(text) {
+		lines = append(lines, "x.C("+strconv.Quote(text[last:])+"),")
+	}
+	return lines
+}
+
+// templateText restores the template source of text holding placeholders
+// and block markers, for TODO output.
+func (c *tmplConverter) templateText(text string) string {
+	text = tmplHoleRe.ReplaceAllStringFunc(text, func(m string) string {
+		id, _ := strconv.Atoi(tmplHoleRe.FindStringSubmatch(m)[1])
+		return c.holes[id].source
+	})
+	return tmplTextMarkerRe.ReplaceAllStringFunc(text, func(m string) string {
+		sub := tmplTextMarkerRe.FindStringSubmatch(m)
+		switch sub[1] {
+		case "open":
+			id, _ := strconv.Atoi(sub[2])
+			return c.blocks[id].source
+		case "else":
+			return "{{else}}"
+		}
+		return "{{end}}"
+	})
+}
+
+// splitMarkers splits text holding block markers into text and comment
+// nodes, as the parser produces for block markers in element content.
+func splitMarkers(text string) []*html.Node {
+	var nodes []*html.Node
+	last := 0
+	for _, m := range tmplTextMarkerRe.FindAllStringIndex(text, -1) {
+		if m[0] > last {
+			nodes = append(nodes, &html.Node{Type: html.TextNode, Data: text[last:m[0]]})
+		}
+		nodes = append(nodes, &html.Node{Type: html.CommentNode, Data: text[m[0]+4 : m[1]-3]})
+		last = m[1]
+	}
+	if last < len(text) {
+		nodes = append(nodes, &html.Node{Type: html.TextNode, Data: text[last:]})
+	}
+	return nodes
+}
+
+func (c *tmplConverter) convertElement(n *html.Node) string {
+	var b strings.Builder
+	if fn, ok := constructorFor(n.Data); ok {
+		b.WriteString(fn + "(\n")
+	} else {
+		fmt.Fprintf(&b, "x.E(%q,\n", n.Data)
+	}
+
+	for _, a := range n.Attr {
+		if m := tmplHoleRe.FindStringSubmatch(a.Key); m != nil {
+			id, _ := strconv.Atoi(m[1])
+			if h := c.holes[id]; h.attr != "" {
+				b.WriteString(h.attr + ",\n")
+			} else {
+				b.WriteString("// TODO: " + todoText(h.todo) + "\n")
+			}
+			continue
+		}
+		context := attrContext(n.Data, a.Key)
+		wrap := func(code string) string { return code }
+		if context == "CSS" {
+			wrap = func(code string) string { return "x.EscapeCSSValue(" + code + ")" }
+		}
+		val, ok := c.attrValue(a.Val, wrap)
+		if !ok {
+			b.WriteString("// TODO: " + todoText(a.Key+"="+strconv.Quote(a.Val)) + "\n")
+			continue
+		}
+		if tmplHoleRe.MatchString(a.Val) {
+			switch context {
+			case "URL":
+				val = "x.SafeURL(" + val + ")"
+			case "JS":
+				b.WriteString("// TODO: html/template escaped " + todoText(a.Key) + " in a JS context; escape the values in it by hand\n")
+			}
+		}
+		b.WriteString(attrCode(a.Key, val) + ",\n")
+	}
+
+	for _, line := range c.convertChildren(n) {
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("),")
+	return b.String()
+}
+
+// attrContext returns the context html/template escapes the value of the
+// attribute key of tag in: "URL", "JS", "CSS", or "" for plain text.
+func attrContext(tag, key string) string {
+	key = strings.ToLower(key)
+	switch {
+	case spec.IsEventHandler(key):
+		return "JS"
+	case key == "style":
+		return "CSS"
+	}
+	a, ok := spec.LookupGlobal(key)
+	if el, found := spec.Lookup(tag); found {
+		a, ok = el.Attribute(key)
+	} else if !ok {
+		a, ok = spec.Attribute{Type: spec.URL}, key == "href" || key == "src" || key == "action" || key == "formaction"
+	}
+	if ok && (a.Type == spec.URL || a.Type == spec.URLList) {
+		return "URL"
+	}
+	return ""
+}
+
+// attrValue translates an attribute value containing placeholders into a
+// string expression, passing the code of each placeholder through wrap.
+func (c *tmplConverter) attrValue(val string, wrap func(string) string) (string, bool) {
+	var parts []string
+	last := 0
+	usesFmt := false
+	for _, m := range tmplHoleRe.FindAllStringSubmatchIndex(val, -1) {
+		if m[0] > last {
+			parts = append(parts, strconv.Quote(val[last:m[0]]))
+		}
+		id, _ := strconv.Atoi(val[m[2]:m[3]])
+		if c.holes[id].str == "" {
+			return "", false
+		}
+		parts = append(parts, wrap(c.holes[id].str))
+		usesFmt = usesFmt || c.holes[id].strFmt
+		last = m[1]
+	}
+	if last < len(val) || len(parts) == 0 {
+		parts = append(parts, strconv.Quote(val[last:]))
+	}
+	if usesFmt {
+		c.imports["fmt"] = true
+	}
+	return strings.Join(parts, " + "), true
+}
+
+// convertChildren converts the children of n, reassembling block actions
+// from their comment markers.
+func (c *tmplConverter) convertChildren(n *html.Node) []string {
+	var nodes []*html.Node
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		nodes = append(nodes, child)
+	}
+	return c.convertSiblings(nodes)
+}
+
+func (c *tmplConverter) convertSiblings(nodes []*html.Node) []string {
+	var lines []string
+	for i := 0; i < len(nodes); i++ {
+		m := markerOf(nodes[i])
+		if m == nil || m[0] != "open" {
+			lines = append(lines, c.convertNode(nodes[i])...)
+			continue
+		}
+
+		// Find the else and close markers of this block among the siblings.
+		elseAt, closeAt := -1, -1
+		for j := i + 1; j < len(nodes) && closeAt < 0; j++ {
+			if mj := markerOf(nodes[j]); mj != nil && mj[1] == m[1] {
+				switch mj[0] {
+				case "else":
+					elseAt = j
+				case "close":
+					closeAt = j
+				}
+			}
+		}
+		if closeAt < 0 {
+			// The parser opens an implied tbody after the open marker when
+			// rows are ranged directly inside a table. Move the marker into
+			// the tbody when it holds the close marker.
+			if next := nextElement(nodes[i+1:]); next != nil && next.DataAtom == atom.Tbody && hasMarker(next, "close", m[1]) {
+				next.InsertBefore(&html.Node{Type: html.CommentNode, Data: nodes[i].Data}, next.FirstChild)
+				continue
+			}
+			lines = append(lines, c.convertNode(nodes[i])...)
+			continue
+		}
+
+		thenEnd := closeAt
+		if elseAt >= 0 {
+			thenEnd = elseAt
+		}
+		then := c.convertSiblings(nodes[i+1 : thenEnd])
+		var els []string
+		if elseAt >= 0 {
+			els = c.convertSiblings(nodes[elseAt+1 : closeAt])
+		}
+		id, _ := strconv.Atoi(m[1])
+		lines = append(lines, c.blockCode(c.blocks[id], then, els, elseAt >= 0)+",")
+		i = closeAt
+	}
+	return lines
+}
+
+// markerOf returns the kind and id of a block marker comment, or nil.
+func markerOf(n *html.Node) []string {
+	if n.Type != html.CommentNode {
+		return nil
+	}
+	if m := tmplMarkerRe.FindStringSubmatch(n.Data); m != nil {
+		return m[1:]
+	}
+	return nil
+}
+
+// trimIndent trims leading and trailing whitespace that spans a line break,
+// keeping single-line spacing between text and actions.
+func trimIndent(s string) string {
+	if strings.TrimSpace(s) == "" {
+		return ""
+	}
+	trimmed := strings.TrimLeft(s, " \t\r\n")
+	if strings.ContainsAny(s[:len(s)-len(trimmed)], "\n") {
+		s = trimmed
+	}
+	trimmed = strings.TrimRight(s, " \t\r\n")
+	if strings.ContainsAny(s[len(trimmed):], "\n") {
+		s = trimmed
+	}
+	return s
+}
+
+// nextElement returns the first element among nodes, skipping whitespace text.
+func nextElement(nodes []*html.Node) *html.Node {
+	for _, n := range nodes {
+		switch {
+		case n.Type == html.ElementNode:
+			return n
+		case n.Type == html.TextNode && strings.TrimSpace(n.Data) == "":
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// hasMarker reports whether n has a direct child marker of the given kind and id.
+func hasMarker(n *html.Node, kind, id string) bool {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if m := markerOf(child); m != nil && m[0] == kind && m[1] == id {
+			return true
+		}
+	}
+	return false
+}
+
+// blockCode assembles the Go code of a block action from its converted branches.
+func (c *tmplConverter) blockCode(b tmplBlock, then, els []string, hasElse bool) string {
+	switch b.kind {
+	case "if":
+		if hasElse {
+			return fmt.Sprintf("x.TER(%s,\n%s,\n%s,\n)", b.cond, fragmentCode(then), fragmentCode(els))
+		}
+		return fmt.Sprintf("x.IF(%s,\n%s,\n)", b.cond, fragmentCode(then))
+	case "range":
+		var s strings.Builder
+		s.WriteString("func() x.Elem {\nvar items []x.Elem\n")
+		if b.todo != "" {
+			s.WriteString("// TODO: " + b.todo + "\n")
+		}
+		if b.mapVar != "" {
+			fmt.Fprintf(&s, "%s := %s\n", b.mapVar, b.expr)
+			fmt.Fprintf(&s, "for _, %s := range x.SortedKeys(%s) {\n", b.key, b.mapVar)
+			fmt.Fprintf(&s, "%s := %s[%s]\n", b.val, b.mapVar, b.key)
+		} else {
+			fmt.Fprintf(&s, "for %s, %s := range %s {\n", b.key, b.val, b.expr)
+		}
+		fmt.Fprintf(&s, "items = append(items, %s)\n}\n", fragmentCode(then))
+		if hasElse {
+			fmt.Fprintf(&s, "if len(items) == 0 {\nreturn %s\n}\n", fragmentCode(els))
+		}
+		s.WriteString("return x.Fragment(items...)\n}()")
+		return s.String()
+	case "with":
+		var s strings.Builder
+		s.WriteString("func() x.Elem {\n")
+		fmt.Fprintf(&s, "if %s := %s; %s {\nreturn %s\n}\n", b.val, b.expr, b.cond, fragmentCode(then))
+		fmt.Fprintf(&s, "return %s\n}()", fragmentCode(els))
+		return s.String()
+	}
+	return "x.Fragment() /* TODO: " + todoText(b.source) + " */"
+}
+
+// fragmentCode joins converted lines into a single expression, wrapping them
+// in x.Fragment unless there is exactly one element.
+func fragmentCode(lines []string) string {
+	var exprs []string
+	for _, l := range lines {
+		if !strings.HasPrefix(l, "//") {
+			exprs = append(exprs, l)
+		}
+	}
+	if len(exprs) == 1 && len(lines) == 1 {
+		return strings.TrimSuffix(exprs[0], ",")
+	}
+	if len(lines) == 0 {
+		return "x.Fragment()"
+	}
+	return "x.Fragment(\n" + strings.Join(lines, "\n") + "\n)"
+}
+
+// attrCode returns the x call for an attribute with the given value expression.
+func attrCode(key, val string) string {
+	if key == "class" {
+		return "x.Class(" + val + ")"
+	}
+	return fmt.Sprintf("x.Att(%q, %s)", key, val)
+}
+
+// parseTemplateHTML parses the HTML of a converted template, as a full
+// document when it starts like one and as a fragment otherwise.
+func parseTemplateHTML(src string) ([]*html.Node, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(src))
+	for strings.HasPrefix(trimmed, "<!--xtpl:") {
+		trimmed = strings.TrimSpace(trimmed[strings.Index(trimmed, "-->")+3:])
+	}
+	if strings.HasPrefix(trimmed, "<!doctype") || strings.HasPrefix(trimmed, "<html") {
+		doc, err := ParseFull(src)
+		if err != nil {
+			return nil, err
+		}
+		return []*html.Node{doc}, nil
+	}
+
+	// Table parts and options are dropped by the parser outside their
+	// parents, so choose the context from the first tag.
+	context := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	z := html.NewTokenizer(strings.NewReader(src))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+		if tt == html.StartTagToken || tt == html.SelfClosingTagToken {
+			name, _ := z.TagName()
+			if parent, ok := fragmentContexts[string(name)]; ok {
+				context = &html.Node{Type: html.ElementNode, Data: parent, DataAtom: atom.Lookup([]byte(parent))}
+			}
+			break
+		}
+	}
+	nodes, err := html.ParseFragment(strings.NewReader(src), context)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing HTML fragment: %w", err)
+	}
+	return nodes, nil
+}
+
+// fragmentContexts maps tags to the parent they must be parsed in.
+var fragmentContexts = map[string]string{
+	"tr": "tbody", "td": "tr", "th": "tr",
+	"thead": "table", "tbody": "table", "tfoot": "table", "caption": "table", "colgroup": "table",
+	"col": "colgroup", "option": "select", "optgroup": "select",
+}
+
+// htmlLexState tracks whether a position in an HTML stream is inside a tag.
+type htmlLexState struct {
+	tag     bool
+	quote   byte
+	comment bool
+}
+
+func (l *htmlLexState) inTag() bool {
+	return l.tag
+}
+
+func (l *htmlLexState) feed(s string) {
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case l.comment:
+			if strings.HasPrefix(s[i:], "-->") {
+				l.comment = false
+				i += 2
+			}
+		case l.quote != 0:
+			if ch == l.quote {
+				l.quote = 0
+			}
+		case l.tag:
+			if ch == '"' || ch == '\'' {
+				l.quote = ch
+			} else if ch == '>' {
+				l.tag = false
+			}
+		case ch == '<':
+			if strings.HasPrefix(s[i:], "<!--") {
+				l.comment = true
+				i += 3
+			} else if i+1 < len(s) && (isASCIILetter(s[i+1]) || s[i+1] == '/' || s[i+1] == '!') {
+				l.tag = true
+			}
+		}
+	}
+}
+
+func isASCIILetter(ch byte) bool {
+	return ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z'
+}
+
+// rangeTypes returns the key and element types produced by ranging over t.
+func rangeTypes(t reflect.Type) (reflect.Type, reflect.Type) {
+	if t == nil {
+		return nil, nil
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		return reflect.TypeOf(0), t.Elem()
+	case reflect.Map:
+		return t.Key(), t.Elem()
+	case reflect.Chan:
+		return nil, t.Elem()
+	}
+	return nil, nil
+}
+
+// orderedKind reports whether map keys of kind k can be sorted with
+// SortedKeys.
+func orderedKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.String, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// lastField returns the last field name of a single-command pipeline, or "".
+func lastField(p *parse.PipeNode) string {
+	if len(p.Cmds) != 1 {
+		return ""
+	}
+	switch n := p.Cmds[0].Args[0].(type) {
+	case *parse.FieldNode:
+		return n.Ident[len(n.Ident)-1]
+	case *parse.VariableNode:
+		if len(n.Ident) > 1 {
+			return n.Ident[len(n.Ident)-1]
+		}
+	case *parse.ChainNode:
+		return n.Field[len(n.Field)-1]
+	}
+	return ""
+}
+
+// singular naively strips a plural "s" from a field name.
+func singular(name string) string {
+	if len(name) > 2 && strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss") {
+		return name[:len(name)-1]
+	}
+	return ""
+}
+
+// tmplFuncName derives an exported Go function name from a template name.
+func tmplFuncName(name string) string {
+	name = strings.TrimSuffix(path.Base(name), path.Ext(name))
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() == 0 || !unicode.IsLetter([]rune(b.String())[0]) {
+		return "Template" + b.String()
+	}
+	return b.String()
+}
+
+// goTypeName returns the name of t as written in the generated package.
+func goTypeName(t reflect.Type) string {
+	if t == nil {
+		return "any"
+	}
+	if t.Kind() == reflect.Pointer && t.Elem().Name() != "" {
+		return "*" + t.Elem().Name()
+	}
+	if t.Name() != "" {
+		return t.Name()
+	}
+	return t.String()
+}
+
+// tmplSource returns the opening action of a block node.
+func tmplSource(node parse.Node) string {
+	switch n := node.(type) {
+	case *parse.IfNode:
+		return "{{if " + n.Pipe.String() + "}}"
+	case *parse.RangeNode:
+		return "{{range " + n.Pipe.String() + "}}"
+	case *parse.WithNode:
+		return "{{with " + n.Pipe.String() + "}}"
+	}
+	return node.String()
+}
+
+func joinCode(args []tmplExpr) string {
+	var codes []string
+	for _, a := range args {
+		codes = append(codes, a.code)
+	}
+	return strings.Join(codes, ", ")
+}
+
+// todoText makes s safe to embed in a Go comment.
+func todoText(s string) string {
+	s = strings.ReplaceAll(s, "\n", " ")
+	return strings.ReplaceAll(s, "*/", "* /")
+}
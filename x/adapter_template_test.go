@@ -0,0 +1,300 @@
+package x
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type tmplTestUser struct {
+	Name  string
+	Admin bool
+}
+
+type tmplTestPage struct {
+	Title  string
+	User   *tmplTestUser
+	Items  []tmplTestUser
+	Count  int
+	URL    string
+	Scores map[string]int
+}
+
+func TestConvertTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		opts     TemplateOptions
+		expected []string
+	}{
+		{
+			name: "Field output",
+			src:  `<h1 class="title">Hello {{.Title}}!</h1>`,
+			opts: TemplateOptions{Data: tmplTestPage{}},
+			expected: []string{
+				"func Page(data tmplTestPage) x.Elem",
+				`x.Class("title")`,
+				`x.C("Hello ")`,
+				"x.C(data.Title)",
+			},
+		},
+		{
+			name: "If and else",
+			src:  `{{if .User}}<p>In</p>{{else}}<p>Out</p>{{end}}`,
+			opts: TemplateOptions{Data: tmplTestPage{}},
+			expected: []string{
+				"x.TER(data.User != nil,",
+			},
+		},
+		{
+			name: "Untyped condition",
+			src:  `{{if .Count}}<p>Some</p>{{end}}`,
+			opts: TemplateOptions{DataType: "Page"},
+			expected: []string{
+				"func Page(data Page) x.Elem",
+				"x.IF(x.Truthy(data.Count),",
+			},
+		},
+		{
+			name: "Range over slice",
+			src:  `<ul>{{range $i, $u := .Items}}<li data-i="{{$i}}">{{$u.Name}}</li>{{end}}</ul>`,
+			opts: TemplateOptions{Data: tmplTestPage{}},
+			expected: []string{
+				"for i, u := range data.Items {",
+				`x.Att("data-i", fmt.Sprint(i))`,
+				"x.C(u.Name)",
+				"return x.Fragment(items...)",
+			},
+		},
+		{
+			name: "Range inside table",
+			src:  `<table>{{range .Items}}<tr><td>{{.Name}}</td></tr>{{end}}</table>`,
+			opts: TemplateOptions{Data: tmplTestPage{}},
+			expected: []string{
+				"for _, item := range data.Items {",
+				"x.C(item.Name)",
+			},
+		},
+		{
+			name: "With",
+			src:  `{{with .User}}<p>{{.Name}}</p>{{end}}`,
+			opts: TemplateOptions{Data: tmplTestPage{}},
+			expected: []string{
+				"if user := data.User; user != nil {",
+				"x.C(user.Name)",
+			},
+		},
+		{
+			name: "Conditional attributes",
+			src:  `<input class="a {{if .Count}}b{{end}}" {{if .User}}disabled{{end}}>`,
+			opts: TemplateOptions{Data: tmplTestPage{}},
+			expected: []string{
+				`x.Class("a "+x.SIF(data.Count != 0, "b"))`,
+				`x.IF(data.User != nil, x.Att("disabled", ""))`,
+			},
+		},
+		{
+			name: "Sub-template",
+			src:  `<div>{{template "user-card" .User}}</div>{{define "user-card"}}<b>{{.Name}}</b>{{end}}`,
+			opts: TemplateOptions{Data: tmplTestPage{}},
+			expected: []string{
+				"UserCard(data.User)",
+				"func UserCard(data *tmplTestUser) x.Elem",
+			},
+		},
+		{
+			name: "URL, JS and CSS attribute contexts",
+			src:  `<a href="{{.Title}}" onclick="go('{{.Title}}')" style="color: {{.Title}}">x</a>`,
+			opts: TemplateOptions{Data: tmplTestPage{}},
+			expected: []string{
+				`x.Att("href", x.SafeURL(data.Title))`,
+				"// TODO: html/template escaped onclick in a JS context",
+				`x.Att("style", "color: "+x.EscapeCSSValue(data.Title))`,
+			},
+		},
+		{
+			name: "Actions inside script",
+			src:  `<script>var t = "{{.Title}}"; {{if .Count}}go(){{end}}</script>`,
+			opts: TemplateOptions{Data: tmplTestPage{}},
+			expected: []string{
+				"// TODO: html/template escaped the actions inside <script>",
+				`x.CR("var t = \"{{.Title}}\"; {{if .Count}}go(){{end}}")`,
+			},
+		},
+		{
+			name: "Actions inside a comment",
+			src:  `<div><!-- {{.Title}} --></div>`,
+			opts: TemplateOptions{Data: tmplTestPage{}},
+			expected: []string{
+				"// TODO: {{.Title}} inside an HTML comment is dropped, as html/template does",
+			},
+		},
+		{
+			name: "Maps ranged in key order",
+			src:  `{{range $k, $v := .Scores}}<li>{{$k}}</li>{{end}}`,
+			opts: TemplateOptions{Data: tmplTestPage{}},
+			expected: []string{
+				"m := data.Scores",
+				"for _, k := range x.SortedKeys(m) {",
+				"v := m[k]",
+			},
+		},
+		{
+			name: "Maps with unordered keys",
+			src:  `{{range .Flags}}<li>{{.}}</li>{{end}}`,
+			opts: TemplateOptions{Data: struct{ Flags map[bool]string }{}},
+			expected: []string{
+				"// TODO: html/template ranges over this map in sorted key order; sort the keys by hand",
+			},
+		},
+		{
+			name: "Untranslatable block",
+			src:  `<div>{{if .Count}}<span>{{end}}</span></div>`,
+			opts: TemplateOptions{Data: tmplTestPage{}},
+			expected: []string{
+				"// TODO: {{if .Count}} spans element boundaries",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := ConvertTemplate("page.html", tt.src, tt.opts)
+			if err != nil {
+				t.Fatalf("ConvertTemplate() returned an error: %v\n%s", err, out)
+			}
+			if _, err := parser.ParseFile(token.NewFileSet(), "page.go", out, 0); err != nil {
+				t.Fatalf("generated code does not parse: %v\n%s", err, out)
+			}
+			for _, want := range tt.expected {
+				if !strings.Contains(out, want) {
+					t.Errorf("expected output to contain %q, got:\n%s", want, out)
+				}
+			}
+		})
+	}
+}
+
+// TestConvertTemplateRenders compiles the converted templates into a
+// program and checks what they render.
+func TestConvertTemplateRenders(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a program")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+
+	tests := []struct {
+		name     string
+		src      string
+		expected string
+	}{
+		{
+			name:     "Field output",
+			src:      `<h1 class="title">Hello {{.Title}}!</h1>`,
+			expected: `<h1 class="title">Hello &lt;T&gt;!</h1>`,
+		},
+		{
+			name:     "Non-string value in content only",
+			src:      `<p>{{.Count}} items</p>`,
+			expected: `<p>2 items</p>`,
+		},
+		{
+			name:     "Maps ranged in key order",
+			src:      `<ul>{{range $k, $v := .Scores}}<li>{{$k}}={{$v}}</li>{{end}}</ul><p>{{range .Scores}}{{.}}{{end}}</p>`,
+			expected: `<ul><li>a=1</li><li>b=2</li><li>c=3</li></ul><p>123</p>`,
+		},
+		{
+			name:     "URL and CSS contexts",
+			src:      `<a href="{{.URL}}" style="color: {{.Title}}">x</a><a href="/u/{{.Count}}">y</a>`,
+			expected: `<a href="#ZgotmplZ" style="color: \3c T\3e ">x</a><a href="/u/2">y</a>`,
+		},
+		{
+			name:     "Blocks inside title and textarea",
+			src:      `<title>{{if .Count}}A{{else}}B{{end}}</title><textarea>{{range .Items}}{{.Name}};{{end}}</textarea>`,
+			expected: `<title>A</title><textarea>Ann;Bob;</textarea>`,
+		},
+		{
+			name:     "Comments and script",
+			src:      `<div><!-- {{.Title}} --></div><script>{{if .Count}}go(){{end}}</script>`,
+			expected: `<div></div><script>{{if .Count}}go(){{end}}</script>`,
+		},
+	}
+
+	dir, err := os.MkdirTemp(".", "testdata-convert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var calls []string
+	for i, tt := range tests {
+		name := fmt.Sprintf("case%d.html", i)
+		out, err := ConvertTemplate(name, tt.src, TemplateOptions{Package: "main", Data: tmplTestPage{}})
+		if err != nil {
+			t.Fatalf("%s: ConvertTemplate() returned an error: %v\n%s", tt.name, err, out)
+		}
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("case%d.go", i)), []byte(out), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		calls = append(calls, tmplFuncName(name)+"(data)")
+	}
+	main := `package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+type tmplTestUser struct {
+	Name  string
+	Admin bool
+}
+
+type tmplTestPage struct {
+	Title string
+	User  *tmplTestUser
+	Items []tmplTestUser
+	Count  int
+	URL    string
+	Scores map[string]int
+}
+
+func main() {
+	data := tmplTestPage{Title: "<T>", Items: []tmplTestUser{{Name: "Ann"}, {Name: "Bob"}}, Count: 2, URL: "javascript:alert(1)", Scores: map[string]int{"c": 3, "a": 1, "b": 2}}
+	for _, e := range []x.Elem{` + strings.Join(calls, ", ") + `} {
+		if err := e.Render(os.Stdout); err != nil {
+			panic(err)
+		}
+		fmt.Println()
+	}
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte(main), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(goTool, "run", "./"+filepath.Base(dir))
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("converted templates do not build or run: %v\n%s", err, out)
+	}
+	got := strings.Split(strings.TrimSuffix(string(out), "\n"), "\n")
+	if len(got) != len(tests) {
+		t.Fatalf("expected %d lines of output, got:\n%s", len(tests), out)
+	}
+	for i, tt := range tests {
+		if got[i] != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.expected, got[i])
+		}
+	}
+}
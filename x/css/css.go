@@ -0,0 +1,314 @@
+// Package css writes stylesheets in Go, next to the components they style.
+// A Sheet holds rules, media queries and custom properties and renders to
+// a style element:
+//
+//	css.Sheet(
+//		css.Rule(":root", css.Props{"--accent": "#0af"}),
+//		css.Rule(css.Class("nav").Child("a"), css.Props{"color": css.Var("--accent")}),
+//		css.Media("(max-width: 600px)", css.Rule(".nav", css.Props{"display": "none"})),
+//	).Elem()
+//
+// Scoped styles belong to one component. Their selectors are prefixed with
+// a class derived from a hash of the rules, so they do not leak into the
+// rest of the page. Components Apply the scope to their root element, which
+// records it during rendering, and ScopedStyles in the head emits the rules
+// of every scope the page used, once each.
+package css
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+// Props holds declarations keyed by property name. Values are escaped with
+// x.EscapeCSSValue. Declarations with an empty value or a property name that
+// is not valid are dropped, and the rest are written sorted by property
+// name, as with x.Styles.
+type Props map[string]string
+
+// Var returns a reference to the custom property name, such as
+// var(--accent), with an optional fallback value.
+func Var(name string, fallback ...string) string {
+	if len(fallback) > 0 {
+		return "var(" + name + ", " + strings.Join(fallback, ", ") + ")"
+	}
+	return "var(" + name + ")"
+}
+
+// Selector is a CSS selector, such as "nav > a" or "a:hover, a:focus".
+type Selector string
+
+// Self is the root element of a scope in scoped rules, such as
+// Self.Pseudo("hover") for the root element when hovered. Scoped selectors
+// without it match descendants of the root element.
+const Self Selector = "&"
+
+// Class returns a selector matching elements with the class name.
+func Class(name string) Selector {
+	return Selector("." + ident(name))
+}
+
+// ID returns a selector matching the element with the id.
+func ID(id string) Selector {
+	return Selector("#" + ident(id))
+}
+
+// Any returns a selector matching elements matched by any of selectors.
+func Any(selectors ...Selector) Selector {
+	list := make([]string, len(selectors))
+	for i, s := range selectors {
+		list[i] = string(s)
+	}
+	return Selector(strings.Join(list, ", "))
+}
+
+// Desc returns a selector matching descendants of s matched by other.
+func (s Selector) Desc(other Selector) Selector {
+	return s + " " + other
+}
+
+// Child returns a selector matching children of s matched by other.
+func (s Selector) Child(other Selector) Selector {
+	return s + " > " + other
+}
+
+// And returns a selector matching elements matched by both s and other,
+// which must be a simple selector such as a class.
+func (s Selector) And(other Selector) Selector {
+	return s + other
+}
+
+// Pseudo returns a selector matching elements of s in the pseudo-class
+// class, such as Pseudo("hover") or Pseudo("nth-child(2n)").
+func (s Selector) Pseudo(class string) Selector {
+	return s + Selector(":"+class)
+}
+
+// Not returns a selector matching elements of s not matched by other.
+func (s Selector) Not(other Selector) Selector {
+	return s + ":not(" + other + ")"
+}
+
+// Item is a part of a stylesheet: a rule or a media query.
+type Item interface {
+	writeCSS(b *strings.Builder, scope string)
+}
+
+type rule struct {
+	selector Selector
+	props    Props
+}
+
+// Rule creates a rule applying props to the elements matched by selector.
+func Rule(selector Selector, props Props) Item {
+	return rule{selector, props}
+}
+
+func (r rule) writeCSS(b *strings.Builder, scope string) {
+	decls := declarations(r.props)
+	if decls == "" {
+		return
+	}
+	b.WriteString(sanitize(scoped(string(r.selector), scope)) + "{" + decls + "}")
+}
+
+type media struct {
+	query string
+	items []Item
+}
+
+// Media creates an @media rule applying items when query matches, such as
+// Media("(max-width: 600px)", ...).
+func Media(query string, items ...Item) Item {
+	return media{query, items}
+}
+
+func (m media) writeCSS(b *strings.Builder, scope string) {
+	var inner strings.Builder
+	for _, item := range m.items {
+		item.writeCSS(&inner, scope)
+	}
+	if inner.Len() > 0 {
+		b.WriteString("@media " + sanitize(m.query) + "{" + inner.String() + "}")
+	}
+}
+
+// Stylesheet is a list of rules and media queries.
+type Stylesheet struct {
+	items []Item
+}
+
+// Sheet creates a stylesheet from items.
+func Sheet(items ...Item) Stylesheet {
+	return Stylesheet{items}
+}
+
+// String returns the CSS of the stylesheet.
+func (s Stylesheet) String() string {
+	return s.css("")
+}
+
+// Elem returns a style element holding the stylesheet.
+func (s Stylesheet) Elem() x.Elem {
+	return x.Style(x.CR(s.String()))
+}
+
+func (s Stylesheet) css(scope string) string {
+	var b strings.Builder
+	for _, item := range s.items {
+		item.writeCSS(&b, scope)
+	}
+	return b.String()
+}
+
+// Scope is a stylesheet whose rules only apply inside the elements it is
+// applied to.
+type Scope struct {
+	class string
+	css   string
+}
+
+// Scoped creates a scope named after a component, such as "card", from
+// items. Its class is the name followed by a hash of the rules, such as
+// card-1a2b3c4d, so changed rules get a new class. Selectors in items are
+// taken relative to the root element: ".title" matches elements with the
+// class title inside it and Self matches the root element itself.
+func Scoped(name string, items ...Item) *Scope {
+	sheet := Sheet(items...)
+	h := fnv.New32a()
+	h.Write([]byte(sheet.String()))
+	class := fmt.Sprintf("%s-%08x", ident(name), h.Sum32())
+	return &Scope{class: class, css: sheet.css("." + class)}
+}
+
+// Class returns the class of the scope.
+func (s *Scope) Class() string {
+	return s.class
+}
+
+// String returns the CSS of the scope, with its selectors prefixed.
+func (s *Scope) String() string {
+	return s.css
+}
+
+// Apply adds the class of the scope to e and records the scope in the
+// render context, so that ScopedStyles emits its rules.
+func (s *Scope) Apply(e x.Elem) x.Elem {
+	e.Children = append(append([]x.Elem(nil), e.Children...), x.Class(s.class))
+	return x.Fragment(x.RenderFunc(func(ctx *x.RenderContext) error {
+		ctx.Effect(func(ctx *x.RenderContext) {
+			used, _ := ctx.Value(scopesKey{}).([]*Scope)
+			for _, u := range used {
+				if u.class == s.class {
+					return
+				}
+			}
+			ctx.SetValue(scopesKey{}, append(used, s))
+		})
+		return nil
+	}), e)
+}
+
+// scopesKey is the RenderContext key holding the scopes used by a render.
+type scopesKey struct{}
+
+// Used returns the scopes applied so far in the render of ctx, in the order
+// they first appeared.
+func Used(ctx *x.RenderContext) []*Scope {
+	used, _ := ctx.Value(scopesKey{}).([]*Scope)
+	return append([]*Scope(nil), used...)
+}
+
+// ScopedStyles creates a style element, rendered once the whole tree has
+// been rendered, holding the rules of every scope the page applied. It
+// renders nothing when no scope was applied. Place it in the head.
+func ScopedStyles() x.Elem {
+	return x.Deferred(func(ctx *x.RenderContext) x.Elem {
+		var b strings.Builder
+		for _, s := range Used(ctx) {
+			b.WriteString(s.css)
+		}
+		if b.Len() == 0 {
+			return x.Elem{}
+		}
+		return x.Style(x.CR(b.String()))
+	})
+}
+
+// declarations writes props as declarations sorted by property name.
+func declarations(props Props) string {
+	names := make([]string, 0, len(props))
+	for name, value := range props {
+		if x.ValidCSSProperty(name) && strings.TrimSpace(value) != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	for i, name := range names {
+		if i > 0 {
+			b.WriteByte(';')
+		}
+		b.WriteString(name + ":" + x.EscapeCSSValue(strings.TrimSpace(props[name])))
+	}
+	return b.String()
+}
+
+// scoped prefixes each selector in the list with scope, replacing Self
+// where it is used.
+func scoped(selector, scope string) string {
+	if scope == "" {
+		return selector
+	}
+	list := splitList(selector)
+	for i, s := range list {
+		s = strings.TrimSpace(s)
+		if strings.Contains(s, string(Self)) {
+			list[i] = strings.ReplaceAll(s, string(Self), scope)
+		} else {
+			list[i] = scope + " " + s
+		}
+	}
+	return strings.Join(list, ", ")
+}
+
+// splitList splits a selector list at the commas outside parentheses.
+func splitList(selector string) []string {
+	var list []string
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				list = append(list, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(list, selector[start:])
+}
+
+// ident replaces the characters of name that cannot appear in a CSS
+// identifier with hyphens.
+func ident(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' {
+			return r
+		}
+		return '-'
+	}, name)
+}
+
+// sanitize escapes the characters of a selector or media query that would
+// end the rule or the style element.
+func sanitize(s string) string {
+	return strings.NewReplacer("{", `\7b `, "}", `\7d `, ";", `\3b `, "<", `\3c `).Replace(s)
+}
@@ -0,0 +1,124 @@
+package css
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+func TestSheet(t *testing.T) {
+	tests := []struct {
+		name     string
+		sheet    Stylesheet
+		expected string
+	}{
+		{
+			name:     "Rules with sorted declarations",
+			sheet:    Sheet(Rule("a:hover", Props{"text-decoration": "underline", "color": "red"})),
+			expected: `a:hover{color:red;text-decoration:underline}`,
+		},
+		{
+			name: "Custom properties",
+			sheet: Sheet(
+				Rule(":root", Props{"--accent": "#0af"}),
+				Rule(Class("nav").Child("a"), Props{"color": Var("--accent"), "border-color": Var("--border", "currentColor")}),
+			),
+			expected: `:root{--accent:#0af}.nav > a{border-color:var(--border, currentColor);color:var(--accent)}`,
+		},
+		{
+			name:     "Media queries",
+			sheet:    Sheet(Media("(max-width: 600px)", Rule(".nav", Props{"display": "none"}), Rule("p", Props{})), Media("print")),
+			expected: `@media (max-width: 600px){.nav{display:none}}`,
+		},
+		{
+			name:     "Selectors",
+			sheet:    Sheet(Rule(Any(ID("main").Desc("p"), Class("btn").And(".primary").Pseudo("focus").Not(".off")), Props{"margin": "0"})),
+			expected: `#main p, .btn.primary:focus:not(.off){margin:0}`,
+		},
+		{
+			name:     "Escaped values and dropped declarations",
+			sheet:    Sheet(Rule("p", Props{"color": "red}</style><script>", "bad name": "1", "width": ""})),
+			expected: `p{color:red\7d \3c /style\3e \3c script\3e }`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sheet.String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := Sheet(Rule("p", Props{"color": "red"})).Elem().Render(&buf); err != nil {
+		t.Fatalf("Render() returned an error: %v", err)
+	}
+	if expected := `<style>p{color:red}</style>`; buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestScoped(t *testing.T) {
+	card := Scoped("card",
+		Rule(Self, Props{"padding": "1rem"}),
+		Rule(Self.Pseudo("hover"), Props{"outline": "1px solid"}),
+		Rule(".title, h2", Props{"font-weight": "bold"}),
+		Media("(min-width: 40em)", Rule(Self.Child("p"), Props{"columns": "2"})),
+	)
+	if !strings.HasPrefix(card.Class(), "card-") || len(card.Class()) != len("card-")+8 {
+		t.Fatalf("unexpected class %q", card.Class())
+	}
+	if other := Scoped("card", Rule(Self, Props{"padding": "2rem"})); other.Class() == card.Class() {
+		t.Errorf("expected different rules to get a different class, both got %q", card.Class())
+	}
+
+	c := "." + card.Class()
+	expected := c + "{padding:1rem}" + c + ":hover{outline:1px solid}" + c + " .title, " + c + " h2{font-weight:bold}" +
+		"@media (min-width: 40em){" + c + " > p{columns:2}}"
+	if card.String() != expected {
+		t.Errorf("expected %q, got %q", expected, card.String())
+	}
+
+	page := x.Html(
+		x.Head(ScopedStyles()),
+		x.Body(
+			card.Apply(x.Div(x.Class("box"), x.H2(x.C("One")))),
+			card.Apply(x.Div(x.C("Two"))),
+		),
+	)
+	var buf bytes.Buffer
+	if err := page.Render(&buf); err != nil {
+		t.Fatalf("Render() returned an error: %v", err)
+	}
+	want := `<html><head><style>` + card.String() + `</style></head><body><div class="box ` + card.Class() + `"><h2>One</h2></div><div class="` + card.Class() + `">Two</div></body></html>`
+	if buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+
+	buf.Reset()
+	if err := x.Html(x.Head(ScopedStyles()), x.Body()).Render(&buf); err != nil {
+		t.Fatalf("Render() returned an error: %v", err)
+	}
+	if want := `<html><head></head><body></body></html>`; buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+
+	// A cached component still emits its scope when served from the store.
+	store := x.NewMemoryFragmentStore(10)
+	cached := x.Html(x.Head(ScopedStyles()), x.Body(x.CacheIn(store, "card", 0, func() x.Elem {
+		return card.Apply(x.Div(x.C("Two")))
+	})))
+	want = `<html><head><style>` + card.String() + `</style></head><body><div class="` + card.Class() + `">Two</div></body></html>`
+	for i := 0; i < 2; i++ {
+		buf.Reset()
+		if err := cached.Render(&buf); err != nil {
+			t.Fatalf("Render() returned an error: %v", err)
+		}
+		if buf.String() != want {
+			t.Errorf("render %d: expected %q, got %q", i+1, want, buf.String())
+		}
+	}
+}
@@ -0,0 +1,337 @@
+// Package htmx provides typed helpers for htmx attributes and for the
+// request and response headers htmx uses.
+package htmx
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+// Get creates an hx-get attribute issuing a GET request to url.
+func Get(url string) x.Elem {
+	return x.Att("hx-get", url)
+}
+
+// Post creates an hx-post attribute issuing a POST request to url.
+func Post(url string) x.Elem {
+	return x.Att("hx-post", url)
+}
+
+// Put creates an hx-put attribute issuing a PUT request to url.
+func Put(url string) x.Elem {
+	return x.Att("hx-put", url)
+}
+
+// Patch creates an hx-patch attribute issuing a PATCH request to url.
+func Patch(url string) x.Elem {
+	return x.Att("hx-patch", url)
+}
+
+// Delete creates an hx-delete attribute issuing a DELETE request to url.
+func Delete(url string) x.Elem {
+	return x.Att("hx-delete", url)
+}
+
+// Target creates an hx-target attribute selecting the element to swap.
+func Target(selector string) x.Elem {
+	return x.Att("hx-target", selector)
+}
+
+// Select creates an hx-select attribute choosing the part of the response to swap in.
+func Select(selector string) x.Elem {
+	return x.Att("hx-select", selector)
+}
+
+// Include creates an hx-include attribute adding the values of other elements to the request.
+func Include(selector string) x.Elem {
+	return x.Att("hx-include", selector)
+}
+
+// Indicator creates an hx-indicator attribute selecting the element shown while the request is in flight.
+func Indicator(selector string) x.Elem {
+	return x.Att("hx-indicator", selector)
+}
+
+// Confirm creates an hx-confirm attribute asking the user before issuing the request.
+func Confirm(message string) x.Elem {
+	return x.Att("hx-confirm", message)
+}
+
+// PushURL creates an hx-push-url attribute pushing url into the browser history.
+func PushURL(url string) x.Elem {
+	return x.Att("hx-push-url", url)
+}
+
+// Boost creates an hx-boost="true" attribute upgrading links and forms to htmx requests.
+func Boost() x.Elem {
+	return x.Att("hx-boost", "true")
+}
+
+// Vals creates an hx-vals attribute holding v encoded as JSON. Values that
+// cannot be encoded make rendering fail, as with x.Island.
+func Vals(v interface{}) x.Elem {
+	return jsonAttr("hx-vals", v)
+}
+
+// Headers creates an hx-headers attribute holding v encoded as JSON. Values
+// that cannot be encoded make rendering fail.
+func Headers(v interface{}) x.Elem {
+	return jsonAttr("hx-headers", v)
+}
+
+// jsonAttr creates the attribute attr holding v encoded as JSON, or a node
+// failing the render with the encoding error.
+func jsonAttr(attr string, v interface{}) x.Elem {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return x.RenderFunc(func(*x.RenderContext) error {
+			return fmt.Errorf("htmx: encoding %s: %w", attr, err)
+		})
+	}
+	return x.Att(attr, string(b))
+}
+
+// SwapMode is an hx-swap strategy, optionally followed by modifiers.
+type SwapMode string
+
+// Swap strategies supported by htmx.
+const (
+	InnerHTML   SwapMode = "innerHTML"
+	OuterHTML   SwapMode = "outerHTML"
+	BeforeBegin SwapMode = "beforebegin"
+	AfterBegin  SwapMode = "afterbegin"
+	BeforeEnd   SwapMode = "beforeend"
+	AfterEnd    SwapMode = "afterend"
+	DeleteSwap  SwapMode = "delete"
+	NoSwap      SwapMode = "none"
+)
+
+// Swap creates an hx-swap attribute.
+func Swap(mode SwapMode) x.Elem {
+	return x.Att("hx-swap", string(mode))
+}
+
+func (m SwapMode) with(modifier string) SwapMode {
+	return SwapMode(string(m) + " " + modifier)
+}
+
+// Transition makes the swap use the View Transitions API.
+func (m SwapMode) Transition() SwapMode {
+	return m.with("transition:true")
+}
+
+// Delay waits d between receiving the response and swapping it in.
+func (m SwapMode) Delay(d time.Duration) SwapMode {
+	return m.with("swap:" + formatDuration(d))
+}
+
+// Settle waits d between swapping the content and settling it.
+func (m SwapMode) Settle(d time.Duration) SwapMode {
+	return m.with("settle:" + formatDuration(d))
+}
+
+// Scroll scrolls the target to "top" or "bottom" after the swap.
+func (m SwapMode) Scroll(position string) SwapMode {
+	return m.with("scroll:" + position)
+}
+
+// Show scrolls the target into view at "top" or "bottom" after the swap.
+func (m SwapMode) Show(position string) SwapMode {
+	return m.with("show:" + position)
+}
+
+// TriggerSpec is one event of an hx-trigger attribute, built with On or Every.
+type TriggerSpec struct {
+	event     string
+	filter    string
+	modifiers []string
+}
+
+// On starts a trigger for the named event.
+func On(event string) TriggerSpec {
+	return TriggerSpec{event: event}
+}
+
+// Every starts a polling trigger firing every d.
+func Every(d time.Duration) TriggerSpec {
+	return TriggerSpec{event: "every " + formatDuration(d)}
+}
+
+func (t TriggerSpec) with(modifier string) TriggerSpec {
+	t.modifiers = append(t.modifiers[:len(t.modifiers):len(t.modifiers)], modifier)
+	return t
+}
+
+// Filter only fires the trigger when the JavaScript expression is true.
+func (t TriggerSpec) Filter(expr string) TriggerSpec {
+	t.filter = expr
+	return t
+}
+
+// Once fires the trigger only the first time the event occurs.
+func (t TriggerSpec) Once() TriggerSpec {
+	return t.with("once")
+}
+
+// Changed fires the trigger only if the value of the element changed.
+func (t TriggerSpec) Changed() TriggerSpec {
+	return t.with("changed")
+}
+
+// Delay waits d after the last event before firing, resetting on new events.
+func (t TriggerSpec) Delay(d time.Duration) TriggerSpec {
+	return t.with("delay:" + formatDuration(d))
+}
+
+// Throttle fires at most once every d.
+func (t TriggerSpec) Throttle(d time.Duration) TriggerSpec {
+	return t.with("throttle:" + formatDuration(d))
+}
+
+// From listens for the event on the elements matching selector.
+func (t TriggerSpec) From(selector string) TriggerSpec {
+	return t.with("from:" + selector)
+}
+
+// Consume stops the event from triggering htmx requests on parent elements.
+func (t TriggerSpec) Consume() TriggerSpec {
+	return t.with("consume")
+}
+
+// Queue sets which events are queued while a request is in flight:
+// "first", "last", "all" or "none".
+func (t TriggerSpec) Queue(which string) TriggerSpec {
+	return t.with("queue:" + which)
+}
+
+// String returns the trigger as written in an hx-trigger attribute.
+func (t TriggerSpec) String() string {
+	s := t.event
+	if t.filter != "" {
+		s += "[" + t.filter + "]"
+	}
+	if len(t.modifiers) > 0 {
+		s += " " + strings.Join(t.modifiers, " ")
+	}
+	return s
+}
+
+// Trigger creates an hx-trigger attribute from one or more triggers.
+func Trigger(specs ...TriggerSpec) x.Elem {
+	parts := make([]string, len(specs))
+	for i, s := range specs {
+		parts[i] = s.String()
+	}
+	return x.Att("hx-trigger", strings.Join(parts, ", "))
+}
+
+// formatDuration formats d in the units htmx accepts.
+func formatDuration(d time.Duration) string {
+	if d%time.Second == 0 {
+		return strconv.FormatInt(int64(d/time.Second), 10) + "s"
+	}
+	return strconv.FormatInt(d.Milliseconds(), 10) + "ms"
+}
+
+// OOB creates an hx-swap-oob="true" attribute, swapping the element into the
+// page element with the same id.
+func OOB() x.Elem {
+	return x.Att("hx-swap-oob", "true")
+}
+
+// OOBSwap creates an hx-swap-oob attribute swapping the element with the
+// given mode into the element matching selector, or into the element with
+// the same id when selector is empty.
+func OOBSwap(mode SwapMode, selector string) x.Elem {
+	if selector == "" {
+		return x.Att("hx-swap-oob", string(mode))
+	}
+	return x.Att("hx-swap-oob", string(mode)+":"+selector)
+}
+
+// OutOfBand marks elem for an out-of-band swap into the page element with
+// the same id, so it can be sent next to the main response.
+func OutOfBand(elem x.Elem) x.Elem {
+	elem.Children = append(elem.Children[:len(elem.Children):len(elem.Children)], OOB())
+	return elem
+}
+
+// IsRequest reports whether r was issued by htmx.
+func IsRequest(r *http.Request) bool {
+	return r.Header.Get("HX-Request") == "true"
+}
+
+// IsBoosted reports whether r was issued by an element using hx-boost.
+func IsBoosted(r *http.Request) bool {
+	return r.Header.Get("HX-Boosted") == "true"
+}
+
+// RequestTarget returns the id of the target element of r, if it has one.
+func RequestTarget(r *http.Request) string {
+	return r.Header.Get("HX-Target")
+}
+
+// RequestTrigger returns the id of the element that triggered r, if it has one.
+func RequestTrigger(r *http.Request) string {
+	return r.Header.Get("HX-Trigger")
+}
+
+// RequestTriggerName returns the name of the element that triggered r, if it has one.
+func RequestTriggerName(r *http.Request) string {
+	return r.Header.Get("HX-Trigger-Name")
+}
+
+// CurrentURL returns the URL of the browser when r was issued.
+func CurrentURL(r *http.Request) string {
+	return r.Header.Get("HX-Current-URL")
+}
+
+// SetTrigger sets the HX-Trigger response header, triggering the named
+// client-side events once the response is received.
+func SetTrigger(w http.ResponseWriter, events ...string) {
+	w.Header().Set("HX-Trigger", strings.Join(events, ", "))
+}
+
+// SetTriggerDetail sets the HX-Trigger response header to events encoded as
+// JSON, triggering each event with its value as the detail.
+func SetTriggerDetail(w http.ResponseWriter, events map[string]interface{}) error {
+	b, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("HX-Trigger", string(b))
+	return nil
+}
+
+// SetRedirect sets the HX-Redirect response header, making the client
+// navigate to url.
+func SetRedirect(w http.ResponseWriter, url string) {
+	w.Header().Set("HX-Redirect", url)
+}
+
+// SetRefresh sets the HX-Refresh response header, making the client reload the page.
+func SetRefresh(w http.ResponseWriter) {
+	w.Header().Set("HX-Refresh", "true")
+}
+
+// SetPushURL sets the HX-Push-Url response header, pushing url into the browser history.
+func SetPushURL(w http.ResponseWriter, url string) {
+	w.Header().Set("HX-Push-Url", url)
+}
+
+// SetRetarget sets the HX-Retarget response header, swapping the response
+// into the element matching selector instead of the requested target.
+func SetRetarget(w http.ResponseWriter, selector string) {
+	w.Header().Set("HX-Retarget", selector)
+}
+
+// SetReswap sets the HX-Reswap response header, overriding the swap mode.
+func SetReswap(w http.ResponseWriter, mode SwapMode) {
+	w.Header().Set("HX-Reswap", string(mode))
+}
@@ -0,0 +1,94 @@
+package htmx
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+func TestAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		elem     x.Elem
+		expected string
+	}{
+		{
+			name:     "Post with target and swap",
+			elem:     x.Button(Post("/cart"), Target("#cart"), Swap(OuterHTML.Transition().Settle(200*time.Millisecond)), x.C("Add")),
+			expected: `<button hx-post="/cart" hx-target="#cart" hx-swap="outerHTML transition:true settle:200ms">Add</button>`,
+		},
+		{
+			name:     "Trigger with modifiers",
+			elem:     x.Input(Get("/search"), Trigger(On("keyup").Filter("key=='Enter'").Changed().Delay(time.Second), Every(30*time.Second))),
+			expected: `<input hx-get="/search" hx-trigger="keyup[key==&#39;Enter&#39;] changed delay:1s, every 30s" />`,
+		},
+		{
+			name:     "Vals encoded as JSON",
+			elem:     x.Div(Vals(map[string]int{"id": 42})),
+			expected: `<div hx-vals="{&#34;id&#34;:42}"></div>`,
+		},
+		{
+			name:     "Out-of-band swap",
+			elem:     OutOfBand(x.Div(x.Att("id", "count"), x.C("3"))),
+			expected: `<div id="count" hx-swap-oob="true">3</div>`,
+		},
+		{
+			name:     "Out-of-band swap with selector",
+			elem:     x.Li(OOBSwap(BeforeEnd, "#list"), x.C("New")),
+			expected: `<li hx-swap-oob="beforeend:#list">New</li>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.elem.Render(&buf); err != nil {
+				t.Fatalf("Render() returned an error: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, buf.String())
+			}
+		})
+	}
+
+	for _, e := range []x.Elem{x.Div(Vals(make(chan int))), x.Div(Headers(func() {}))} {
+		if err := e.Render(&bytes.Buffer{}); err == nil {
+			t.Error("expected an error for a value that cannot be encoded")
+		}
+	}
+}
+
+func TestHeaders(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/cart", nil)
+	r.Header.Set("HX-Request", "true")
+	r.Header.Set("HX-Target", "cart")
+	if !IsRequest(r) || RequestTarget(r) != "cart" {
+		t.Errorf("expected an htmx request targeting cart")
+	}
+
+	rec := httptest.NewRecorder()
+	SetTrigger(rec, "cartUpdated", "flash")
+	SetRedirect(rec, "/login")
+	SetRetarget(rec, "#errors")
+	expected := map[string]string{
+		"HX-Trigger":  "cartUpdated, flash",
+		"HX-Redirect": "/login",
+		"HX-Retarget": "#errors",
+	}
+	for k, v := range expected {
+		if got := rec.Header().Get(k); got != v {
+			t.Errorf("expected %s %q, got %q", k, v, got)
+		}
+	}
+
+	if err := SetTriggerDetail(rec, map[string]interface{}{"saved": map[string]int{"id": 1}}); err != nil {
+		t.Fatalf("SetTriggerDetail() returned an error: %v", err)
+	}
+	if got := rec.Header().Get("HX-Trigger"); got != `{"saved":{"id":1}}` {
+		t.Errorf("expected JSON trigger, got %q", got)
+	}
+}
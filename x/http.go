@@ -0,0 +1,195 @@
+package x
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+)
+
+// HTTPError is an error carrying the HTTP status a handler should respond with.
+type HTTPError struct {
+	Status int   // HTTP status code
+	Err    error // Underlying error, if any
+}
+
+// Error returns the underlying error message, or the status text.
+func (e HTTPError) Error() string {
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return http.StatusText(e.Status)
+}
+
+// Unwrap returns the underlying error.
+func (e HTTPError) Unwrap() error {
+	return e.Err
+}
+
+// Error wraps err with an HTTP status for Handler to respond with.
+func Error(status int, err error) error {
+	return HTTPError{Status: status, Err: err}
+}
+
+// Write renders elem into a buffer and writes it as an HTML response with
+// the given status. Nothing is written if rendering fails, so the caller can
+// still respond with an error page.
+func Write(w http.ResponseWriter, status int, elem Elem) error {
+	var buf bytes.Buffer
+	if err := elem.Render(&buf); err != nil {
+		return err
+	}
+	return writeBuffer(w, status, &buf)
+}
+
+// writeBuffer writes a rendered page as an HTML response.
+func writeBuffer(w http.ResponseWriter, status int, buf *bytes.Buffer) error {
+	setHTMLContentType(w)
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(status)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// Stream renders elem directly to the response with the given status,
+// without buffering. A render error leaves a partially written response.
+func Stream(w http.ResponseWriter, status int, elem Elem) error {
+	setHTMLContentType(w)
+	w.WriteHeader(status)
+	return elem.Render(w)
+}
+
+// setHTMLContentType sets the HTML content type unless one is already set.
+func setHTMLContentType(w http.ResponseWriter) {
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	}
+}
+
+// PageHandler is an http.Handler serving the element returned by a function.
+type PageHandler struct {
+	fn      func(*http.Request) (Elem, error)
+	stream  bool
+	onError func(*http.Request, error) (int, Elem)
+	etag    bool
+	policy  CachePolicy
+	version func(*http.Request) (string, error)
+	strict  bool
+}
+
+// Handler returns an http.Handler that renders the element returned by fn.
+// The page is buffered, so a failure from fn or from rendering becomes an
+// error page instead of a half-written response. Return an error created
+// with Error to choose the status code.
+func Handler(fn func(*http.Request) (Elem, error)) PageHandler {
+	return PageHandler{fn: fn, onError: DefaultErrorPage}
+}
+
+// Stream makes the handler render directly to the response instead of
+// buffering, for large pages. Errors from the handler function still produce
+// an error page; a render error aborts the response.
+func (h PageHandler) Stream() PageHandler {
+	h.stream = true
+	return h
+}
+
+// Strict makes the handler check each page with Validate. A page with
+// issues fails with a *ValidationError, which reaches the error page like
+// any other error, so content model violations surface during development.
+func (h PageHandler) Strict() PageHandler {
+	h.strict = true
+	return h
+}
+
+// OnError sets the function building the error page and its status.
+func (h PageHandler) OnError(fn func(r *http.Request, err error) (int, Elem)) PageHandler {
+	h.onError = fn
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h PageHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var etag string
+	if h.version != nil {
+		key, err := h.version(r)
+		if err != nil {
+			h.fail(w, r, err)
+			return
+		}
+		etag = versionETag(key)
+		if notModified(w, r, etag, h.policy) {
+			return
+		}
+	}
+
+	elem, err := h.fn(r)
+	if err != nil {
+		h.fail(w, r, err)
+		return
+	}
+	if h.strict {
+		if issues := Validate(elem); len(issues) > 0 {
+			h.fail(w, r, &ValidationError{Issues: issues})
+			return
+		}
+	}
+
+	if h.stream && (!h.etag || etag != "") {
+		if h.etag {
+			setCacheHeaders(w, etag, h.policy)
+		}
+		if err := Stream(w, http.StatusOK, elem); err != nil {
+			log.Printf("x: streaming %s: %v", r.URL.Path, err)
+			// The status is already sent; abort so the client does not
+			// mistake a truncated page for a complete one.
+			panic(http.ErrAbortHandler)
+		}
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := elem.Render(&buf); err != nil {
+		h.fail(w, r, err)
+		return
+	}
+	if h.etag {
+		if etag == "" {
+			etag = contentETag(buf.Bytes())
+			if notModified(w, r, etag, h.policy) {
+				return
+			}
+		}
+		setCacheHeaders(w, etag, h.policy)
+	}
+	writeBuffer(w, http.StatusOK, &buf)
+}
+
+// fail writes the error page for err.
+func (h PageHandler) fail(w http.ResponseWriter, r *http.Request, err error) {
+	status, page := h.onError(r, err)
+	if status >= http.StatusInternalServerError {
+		log.Printf("x: serving %s: %v", r.URL.Path, err)
+	}
+	if err := Write(w, status, page); err != nil {
+		http.Error(w, http.StatusText(status), status)
+	}
+}
+
+// DefaultErrorPage returns the status carried by an HTTPError, or 500, and a
+// minimal page showing the status text. The error message is not shown.
+func DefaultErrorPage(r *http.Request, err error) (int, Elem) {
+	status := http.StatusInternalServerError
+	var httpErr HTTPError
+	if errors.As(err, &httpErr) {
+		status = httpErr.Status
+	}
+	text := http.StatusText(status)
+	return status, Fragment(
+		DOCTYPE(),
+		Html(
+			Head(Title(C(text))),
+			Body(H1(C(text))),
+		),
+	)
+}
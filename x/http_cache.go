@@ -0,0 +1,129 @@
+package x
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CachePolicy describes the Cache-Control header sent with cached pages.
+// The zero policy requires revalidation on every request, which suits pages
+// served with an ETag.
+type CachePolicy struct {
+	MaxAge               time.Duration // How long the response is fresh
+	StaleWhileRevalidate time.Duration // How long a stale response may be served while revalidating
+	Private              bool          // Only the browser may store the response, not shared caches
+	Immutable            bool          // The response never changes while fresh
+	NoStore              bool          // The response must not be stored at all
+}
+
+// String returns the Cache-Control header value of the policy.
+func (p CachePolicy) String() string {
+	if p.NoStore {
+		return "no-store"
+	}
+	var directives []string
+	if p.Private {
+		directives = append(directives, "private")
+	} else {
+		directives = append(directives, "public")
+	}
+	if p.MaxAge <= 0 {
+		directives = append(directives, "no-cache")
+	} else {
+		directives = append(directives, "max-age="+strconv.Itoa(int(p.MaxAge.Seconds())))
+	}
+	if p.StaleWhileRevalidate > 0 {
+		directives = append(directives, "stale-while-revalidate="+strconv.Itoa(int(p.StaleWhileRevalidate.Seconds())))
+	}
+	if p.Immutable {
+		directives = append(directives, "immutable")
+	}
+	return strings.Join(directives, ", ")
+}
+
+// WriteCached renders elem, sets a strong ETag computed from the output and
+// the Cache-Control header of policy, and answers a matching If-None-Match
+// with 304 Not Modified instead of the page. Nothing is written if
+// rendering fails.
+func WriteCached(w http.ResponseWriter, r *http.Request, elem Elem, policy CachePolicy) error {
+	var buf bytes.Buffer
+	if err := elem.Render(&buf); err != nil {
+		return err
+	}
+	etag := contentETag(buf.Bytes())
+	if notModified(w, r, etag, policy) {
+		return nil
+	}
+	setCacheHeaders(w, etag, policy)
+	return writeBuffer(w, http.StatusOK, &buf)
+}
+
+// Cache makes the handler send a strong ETag computed from the rendered page
+// and the Cache-Control header of policy, answering a matching If-None-Match
+// with 304 Not Modified. Computing the ETag requires buffering, so streaming
+// is disabled unless a Version function supplies the ETag.
+func (h PageHandler) Cache(policy CachePolicy) PageHandler {
+	h.etag = true
+	h.policy = policy
+	return h
+}
+
+// Version makes the handler derive the ETag from the key returned by fn
+// instead of the rendered output. A request whose If-None-Match matches the
+// key is answered with 304 Not Modified without rendering the page.
+func (h PageHandler) Version(fn func(r *http.Request) (string, error)) PageHandler {
+	h.etag = true
+	h.version = fn
+	return h
+}
+
+// notModified writes a 304 response and reports true if r is a GET or HEAD
+// request whose If-None-Match header matches etag.
+func notModified(w http.ResponseWriter, r *http.Request, etag string, policy CachePolicy) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+	if !etagMatch(r.Header.Get("If-None-Match"), etag) {
+		return false
+	}
+	setCacheHeaders(w, etag, policy)
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+func setCacheHeaders(w http.ResponseWriter, etag string, policy CachePolicy) {
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", policy.String())
+}
+
+// etagMatch reports whether an If-None-Match header value matches etag,
+// using the weak comparison required for If-None-Match.
+func etagMatch(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// contentETag returns a strong ETag for rendered output.
+func contentETag(content []byte) string {
+	sum := sha256.Sum256(content)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// versionETag returns a strong ETag for a caller-supplied version key.
+func versionETag(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return `"v-` + hex.EncodeToString(sum[:16]) + `"`
+}
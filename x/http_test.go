@@ -0,0 +1,140 @@
+package x
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandler(t *testing.T) {
+	failing := Elem{Type: NodeType(99)}
+
+	tests := []struct {
+		name         string
+		handler      http.Handler
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name: "Renders page",
+			handler: Handler(func(r *http.Request) (Elem, error) {
+				return P(C("Hello")), nil
+			}),
+			expectedCode: http.StatusOK,
+			expectedBody: "<p>Hello</p>",
+		},
+		{
+			name: "Handler error with status",
+			handler: Handler(func(r *http.Request) (Elem, error) {
+				return Elem{}, Error(http.StatusNotFound, errors.New("no such page"))
+			}),
+			expectedCode: http.StatusNotFound,
+			expectedBody: "<!DOCTYPE html><html><head><title>Not Found</title></head><body><h1>Not Found</h1></body></html>",
+		},
+		{
+			name: "Render error becomes error page",
+			handler: Handler(func(r *http.Request) (Elem, error) {
+				return Div(failing), nil
+			}).OnError(func(r *http.Request, err error) (int, Elem) {
+				return http.StatusInternalServerError, P(C("Oops"))
+			}),
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "<p>Oops</p>",
+		},
+		{
+			name: "Streams page",
+			handler: Handler(func(r *http.Request) (Elem, error) {
+				return P(C("Streamed")), nil
+			}).Stream(),
+			expectedCode: http.StatusOK,
+			expectedBody: "<p>Streamed</p>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+			if rec.Code != tt.expectedCode {
+				t.Errorf("expected status %d, got %d", tt.expectedCode, rec.Code)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct != "text/html; charset=utf-8" {
+				t.Errorf("expected HTML content type, got %q", ct)
+			}
+			if rec.Body.String() != tt.expectedBody {
+				t.Errorf("expected %q, got %q", tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	rec := httptest.NewRecorder()
+	err := Write(rec, http.StatusCreated, Div(C("Created")))
+	if err != nil {
+		t.Fatalf("Write() returned an error: %v", err)
+	}
+	if rec.Code != http.StatusCreated {
+		t.Errorf("expected status %d, got %d", http.StatusCreated, rec.Code)
+	}
+	if rec.Body.String() != "<div>Created</div>" {
+		t.Errorf("expected %q, got %q", "<div>Created</div>", rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	if err := Write(rec, http.StatusOK, Elem{Type: NodeType(99)}); err == nil {
+		t.Fatal("expected Write() to return a render error")
+	}
+	if rec.Body.Len() != 0 || len(rec.Header()) != 0 {
+		t.Errorf("expected nothing written on render error, got %q", rec.Body.String())
+	}
+}
+
+func TestPageHandler_Cache(t *testing.T) {
+	renders := 0
+	page := func(r *http.Request) (Elem, error) {
+		renders++
+		return P(C("Cached")), nil
+	}
+
+	h := Handler(page).Cache(CachePolicy{MaxAge: time.Minute})
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	etag := rec.Header().Get("ETag")
+	if rec.Code != http.StatusOK || etag == "" {
+		t.Fatalf("expected 200 with an ETag, got %d %q", rec.Code, etag)
+	}
+	if cc := rec.Header().Get("Cache-Control"); cc != "public, max-age=60" {
+		t.Errorf("expected Cache-Control %q, got %q", "public, max-age=60", cc)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("If-None-Match", `"other", `+etag)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Errorf("expected empty 304, got %d %q", rec.Code, rec.Body.String())
+	}
+
+	renders = 0
+	versioned := Handler(page).Version(func(r *http.Request) (string, error) {
+		return "v1", nil
+	})
+	rec = httptest.NewRecorder()
+	versioned.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("If-None-Match", rec.Header().Get("ETag"))
+	rec = httptest.NewRecorder()
+	versioned.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("expected 304, got %d", rec.Code)
+	}
+	if renders != 1 {
+		t.Errorf("expected the page to render once, rendered %d times", renders)
+	}
+	if cc := rec.Header().Get("Cache-Control"); cc != "public, no-cache" {
+		t.Errorf("expected Cache-Control %q, got %q", "public, no-cache", cc)
+	}
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/zulubit/xxhtml/x/spec"
+)
+
+// handWritten lists helpers defined in the attributes template, which
+// generated helpers must not collide with.
+var handWritten = map[string]bool{"Data": true, "Aria": true}
+
+// compounds spells the Go names of attributes made of several words.
+var compounds = map[string]string{
+	"accept-charset": "AcceptCharset", "accesskey": "AccessKey", "allowfullscreen": "AllowFullscreen",
+	"autocapitalize": "AutoCapitalize", "autocomplete": "AutoComplete", "autocorrect": "AutoCorrect",
+	"autofocus": "AutoFocus", "autoplay": "AutoPlay", "closedby": "ClosedBy", "colorspace": "ColorSpace",
+	"colspan": "ColSpan", "commandfor": "CommandFor", "contenteditable": "ContentEditable",
+	"crossorigin": "CrossOrigin", "datetime": "DateTime", "dirname": "DirName", "enctype": "EncType",
+	"enterkeyhint": "EnterKeyHint", "fetchpriority": "FetchPriority", "formaction": "FormAction",
+	"formenctype": "FormEncType", "formmethod": "FormMethod", "formnovalidate": "FormNoValidate",
+	"formtarget": "FormTarget", "hreflang": "HrefLang", "http-equiv": "HTTPEquiv", "id": "ID",
+	"imagesizes": "ImageSizes", "imagesrcset": "ImageSrcSet", "inputmode": "InputMode", "ismap": "IsMap",
+	"itemid": "ItemID", "itemprop": "ItemProp", "itemref": "ItemRef", "itemscope": "ItemScope",
+	"itemtype": "ItemType", "maxlength": "MaxLength", "minlength": "MinLength", "nomodule": "NoModule",
+	"novalidate": "NoValidate", "playsinline": "PlaysInline", "popovertarget": "PopoverTarget",
+	"popovertargetaction": "PopoverTargetAction", "readonly": "ReadOnly", "referrerpolicy": "ReferrerPolicy",
+	"rowspan": "RowSpan", "shadowrootclonable": "ShadowRootClonable",
+	"shadowrootdelegatesfocus": "ShadowRootDelegatesFocus", "shadowrootmode": "ShadowRootMode",
+	"shadowrootserializable": "ShadowRootSerializable", "spellcheck": "SpellCheck", "srcdoc": "SrcDoc",
+	"srclang": "SrcLang", "srcset": "SrcSet", "tabindex": "TabIndex", "usemap": "UseMap",
+	"writingsuggestions": "WritingSuggestions", "xmlns": "XMLNS",
+}
+
+// enum is an attribute whose values get typed constants.
+type enum struct {
+	Attr     string
+	Type     string
+	Prefix   string
+	Doc      string
+	Values   []string
+	Variadic bool
+	Example  string
+	// Helper, when set, names a separate helper taking Type, for attributes
+	// whose values depend on the element; the attribute helper then takes
+	// any string.
+	Helper string
+}
+
+var enums = []enum{
+	{
+		Attr: "type", Type: "InputType", Prefix: "Input", Values: spec.InputTypes, Helper: "InputTypeAttr",
+		Doc:     "InputType is a value of the type attribute of input.",
+		Example: `such as Type("module") on script. Use InputTypeAttr for input`,
+	},
+	{
+		Attr: "rel", Type: "LinkType", Prefix: "Rel", Values: spec.LinkTypes, Variadic: true,
+		Doc:     "LinkType is a value of the rel attribute.",
+		Example: "to the given link types, such as Rel(RelNoopener, RelNoreferrer)",
+	},
+	{
+		Attr: "target", Type: "TargetName", Prefix: "Target", Values: spec.Targets,
+		Doc:     "TargetName is a value of the target attribute: one of the keywords below or the name of a window or frame.",
+		Example: `such as Target(TargetBlank), or Target(TargetName("preview")) for a frame`,
+	},
+}
+
+// attr is a generated attribute helper.
+type attr struct {
+	Name   string // Attribute name
+	Func   string // Helper name
+	Kind   string // "bool", "int", "float", "string" or "enum"
+	Values []string
+	Enum   *enum
+}
+
+type constant struct {
+	Name, Value string
+}
+
+func (e enum) Constants() []constant {
+	var cs []constant
+	for _, v := range e.Values {
+		cs = append(cs, constant{Name: e.Prefix + goName(strings.TrimPrefix(v, "_")), Value: v})
+	}
+	return cs
+}
+
+// goName returns the Go spelling of an attribute name or keyword.
+func goName(s string) string {
+	if name, ok := compounds[s]; ok {
+		return name
+	}
+	var b strings.Builder
+	for _, part := range strings.Split(s, "-") {
+		b.WriteString(word(part))
+	}
+	return b.String()
+}
+
+// initialisms are the words Go names spell in upper case, such as the JSON
+// of AsJSON.
+var initialisms = map[string]bool{
+	"api": true, "css": true, "dns": true, "html": true, "http": true,
+	"https": true, "id": true, "json": true, "ltr": true, "rtl": true,
+	"ua": true, "uri": true, "url": true, "utf": true, "xml": true,
+}
+
+// word capitalizes a word of a Go name, or upper-cases an initialism.
+func word(part string) string {
+	if initialisms[part] {
+		return strings.ToUpper(part)
+	}
+	if part == "" {
+		return ""
+	}
+	return strings.ToUpper(part[:1]) + part[1:]
+}
+
+// attributesData collects the global attributes and those of every
+// element. An attribute whose type differs between elements takes a
+// string.
+func attributesData() (interface{}, error) {
+	types := make(map[string]spec.Attribute)
+	var names []string
+	add := func(a spec.Attribute) {
+		prev, seen := types[a.Name]
+		if !seen {
+			names = append(names, a.Name)
+			types[a.Name] = a
+			return
+		}
+		if prev.Type != a.Type {
+			types[a.Name] = spec.Attribute{Name: a.Name, Type: spec.String}
+		}
+	}
+	for _, a := range spec.GlobalAttributes {
+		add(a)
+	}
+	elements := make(map[string]bool)
+	for _, e := range spec.Elements {
+		elements[e.GoName()] = true
+		for _, a := range e.Attributes {
+			add(a)
+		}
+	}
+	sort.Strings(names)
+
+	var attrs []attr
+	funcs := make(map[string]string)
+	for _, name := range names {
+		a := types[name]
+		h := attr{Name: name, Func: goName(name), Kind: "string"}
+		if elements[h.Func] || handWritten[h.Func] {
+			h.Func += "Attr"
+		}
+		if other, dup := funcs[h.Func]; dup {
+			return nil, fmt.Errorf("attributes %q and %q both map to %s", other, name, h.Func)
+		}
+		funcs[h.Func] = name
+		switch a.Type {
+		case spec.Boolean:
+			h.Kind = "bool"
+		case spec.Integer:
+			h.Kind = "int"
+		case spec.Number:
+			h.Kind = "float"
+		case spec.Enum:
+			h.Values = a.Values
+		}
+		for i := range enums {
+			if enums[i].Attr == name {
+				h.Kind, h.Enum = "enum", &enums[i]
+			}
+		}
+		attrs = append(attrs, h)
+	}
+	return struct {
+		Attrs []attr
+		Enums []enum
+	}{attrs, enums}, nil
+}
+
+var attributesTmpl = template.Must(template.New("attributes").Funcs(template.FuncMap{
+	"list": func(values []string) string {
+		if len(values) < 2 {
+			return strings.Join(values, "")
+		}
+		return strings.Join(values[:len(values)-1], ", ") + " or " + values[len(values)-1]
+	},
+}).Parse(`// Code generated by internal/genhtml from package spec. DO NOT EDIT.
+
+package x
+
+import "strings"
+{{range .Enums}}{{$type := .Type}}
+// {{.Doc}}
+type {{.Type}} string
+
+const (
+{{- range .Constants}}
+	{{.Name}} {{$type}} = "{{.Value}}"{{end}}
+)
+{{end}}
+{{- range .Attrs}}
+{{if eq .Kind "bool" -}}
+// {{.Func}} sets the boolean {{.Name}} attribute.
+func {{.Func}}() Elem {
+	return AttBool("{{.Name}}", true)
+}
+{{- else if eq .Kind "int" -}}
+// {{.Func}} sets the {{.Name}} attribute to an integer.
+func {{.Func}}(n int) Elem {
+	return AttInt("{{.Name}}", n)
+}
+{{- else if eq .Kind "float" -}}
+// {{.Func}} sets the {{.Name}} attribute to a number.
+func {{.Func}}(n float64) Elem {
+	return AttFloat("{{.Name}}", n)
+}
+{{- else if eq .Kind "enum" -}}
+// {{.Func}} sets the {{.Name}} attribute, {{.Enum.Example}}.
+{{- if .Enum.Helper}}
+func {{.Func}}(value string) Elem {
+	return Att("{{.Name}}", value)
+}
+
+// {{.Enum.Helper}} sets the {{.Name}} attribute to one of the {{.Enum.Type}} constants.
+func {{.Enum.Helper}}(value {{.Enum.Type}}) Elem {
+	return Att("{{.Name}}", string(value))
+}
+{{- else if .Enum.Variadic}}
+func {{.Func}}(values ...{{.Enum.Type}}) Elem {
+	return Att("{{.Name}}", joinTokens(values))
+}
+{{- else}}
+func {{.Func}}(value {{.Enum.Type}}) Elem {
+	return Att("{{.Name}}", string(value))
+}
+{{- end}}
+{{- else -}}
+// {{.Func}} sets the {{.Name}} attribute{{with .Values}} to {{list .}}{{end}}.
+func {{.Func}}(value string) Elem {
+	return Att("{{.Name}}", value)
+}
+{{- end}}
+{{end}}
+// Data sets the custom data-key attribute, such as Data("id", "42") for
+// data-id="42".
+func Data(key, value string) Elem {
+	return Att("data-"+key, value)
+}
+
+// Aria sets the aria-key attribute, such as Aria("expanded", "true") for
+// aria-expanded="true".
+func Aria(key, value string) Elem {
+	return Att("aria-"+key, value)
+}
+
+func joinTokens[T ~string](values []T) string {
+	tokens := make([]string, len(values))
+	for i, v := range values {
+		tokens[i] = string(v)
+	}
+	return strings.Join(tokens, " ")
+}
+`))
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"text/template"
+
+	"github.com/zulubit/xxhtml/x/spec"
+)
+
+var elementsTmpl = template.Must(template.New("elements").Parse(`// Code generated by internal/genhtml from package spec. DO NOT EDIT.
+
+package x
+
+// Convenience functions
+{{range .}}
+// {{.GoName}} creates a new <{{.Name}}>{{with .Description}} ({{.}}){{end}} element{{if .Void}}. This is a self-closing tag.{{else}} with optional children.{{end}}
+{{- if .Obsolete}}
+//
+// Deprecated: <{{.Name}}> is obsolete in the HTML standard.
+{{- end}}
+func {{.GoName}}(children ...Elem) Elem {
+	return E("{{.Name}}", children...){{if .Void}}.SelfClose(){{end}}
+}
+{{end}}
+// DOCTYPE generates the raw <!DOCTYPE html> declaration.
+func DOCTYPE() Elem {
+	return CR("<!DOCTYPE html>")
+}
+`))
+
+func elementsData() (interface{}, error) {
+	return spec.Elements, nil
+}
@@ -0,0 +1,61 @@
+// Command genhtml writes the generated files of package x, its element
+// constructors and attribute helpers, and the element builders of package
+// typed from the tables in package spec. Run it through go generate in the
+// x directory.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+// output is a generated file.
+type output struct {
+	name string
+	tmpl *template.Template
+	data func() (interface{}, error)
+}
+
+var outputs = []output{
+	{name: "x_elements.go", tmpl: elementsTmpl, data: elementsData},
+	{name: "x_attributes.go", tmpl: attributesTmpl, data: attributesData},
+	{name: "typed/builders.go", tmpl: typedTmpl, data: typedData},
+}
+
+func main() {
+	dir := flag.String("dir", ".", "directory of package x")
+	flag.Parse()
+
+	for _, out := range outputs {
+		src, err := generate(out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(*dir, out.name), src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// generate returns the formatted source of an output.
+func generate(out output) ([]byte, error) {
+	data, err := out.data()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", out.name, err)
+	}
+	var buf bytes.Buffer
+	if err := out.tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("%s: %w", out.name, err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s: formatting generated code: %w", out.name, err)
+	}
+	return src, nil
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	for _, out := range outputs {
+		t.Run(out.name, func(t *testing.T) {
+			want, err := generate(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := os.ReadFile(filepath.Join("..", "..", out.name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s is out of date; run go generate in the x directory", out.name)
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	for in, want := range map[string]string{
+		"accept-charset": "AcceptCharset",
+		"referrer-url":   "ReferrerURL",
+		"http-equiv":     "HTTPEquiv",
+		"json":           "JSON",
+		"id":             "ID",
+	} {
+		if got := goName(in); got != want {
+			t.Errorf("goName(%q): expected %q, got %q", in, want, got)
+		}
+	}
+	for in, want := range map[string]string{
+		"rtl":                               "RTL",
+		"utf-8":                             "UTF8",
+		"application/x-www-form-urlencoded": "ApplicationXWwwFormUrlencoded",
+	} {
+		if got := keywordName(in); got != want {
+			t.Errorf("keywordName(%q): expected %q, got %q", in, want, got)
+		}
+	}
+}
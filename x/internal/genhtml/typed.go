@@ -0,0 +1,365 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/zulubit/xxhtml/x/spec"
+)
+
+// aliases maps attributes to the attribute whose values they share, so
+// that both take the same value type.
+var aliases = map[string]string{"formenctype": "enctype", "formmethod": "method", "formtarget": "target"}
+
+// baseMethods lists the methods of every builder, which generated
+// attribute methods must not collide with.
+var baseMethods = map[string]bool{"Elem": true, "Render": true, "Att": true, "Data": true, "Aria": true, "Children": true}
+
+// valueType is a string type whose constants are the keywords of one or
+// more attributes.
+type valueType struct {
+	Name      string
+	Attrs     []string
+	Elem      string // Tag of the only element using the type, if any
+	Open      bool   // Whether values other than the keywords are allowed
+	Constants []constant
+}
+
+// method sets one attribute on a builder.
+type method struct {
+	Name     string
+	Attr     string
+	Kind     string // "bool", "int", "float", "string" or "value"
+	Type     string // Parameter type for "value"
+	Variadic bool
+}
+
+// builder is the builder of one element.
+type builder struct {
+	Name        string // Constructor name
+	Func        string // Constructor in package x
+	Tag         string
+	Description string
+	Void        bool
+	Obsolete    bool
+	Methods     []method
+}
+
+// exported returns the Go name of an element in package typed. Unlike
+// package x, typed has no Data helper in the way, so no element needs
+// another name.
+func exported(tag string) string {
+	return strings.ToUpper(tag[:1]) + tag[1:]
+}
+
+// keywordName returns the Go spelling of an attribute keyword, such as
+// Noopener for "noopener" or UTF8 for "utf-8".
+func keywordName(s string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		b.WriteString(word(part))
+	}
+	return b.String()
+}
+
+// constants returns the constants of a value type for attr, or false when
+// two keywords differ only in case or punctuation and cannot both be named.
+// They are prefixed with the type name, less Type for the type attribute
+// (InputEmail), except that the targets are plain (Blank).
+func constants(typ, attr string, values []string) ([]constant, bool) {
+	prefix := typ
+	switch attr {
+	case "type":
+		prefix = strings.TrimSuffix(typ, "Type")
+	case "target":
+		prefix = ""
+	}
+	var cs []constant
+	seen := make(map[string]bool)
+	for _, v := range values {
+		name := keywordName(v)
+		if name == "" {
+			continue
+		}
+		name = prefix + name
+		if seen[name] {
+			return nil, false
+		}
+		seen[name] = true
+		cs = append(cs, constant{Name: name, Value: v})
+	}
+	return cs, len(cs) > 0
+}
+
+// valueTypes returns the value types of the attributes that list keywords,
+// keyed by tag and attribute name; global attributes have an empty tag. An
+// attribute whose keywords are the same wherever it appears shares one
+// type named after it, such as Target. Otherwise each element gets its own,
+// such as InputType and ButtonType.
+func valueTypes() ([]valueType, map[[2]string]string) {
+	type use struct {
+		tag  string
+		attr spec.Attribute
+	}
+	uses := make(map[string][]use)
+	var names []string
+	add := func(tag string, a spec.Attribute) {
+		name := a.Name
+		if alias, ok := aliases[name]; ok {
+			name = alias
+		}
+		if _, seen := uses[name]; !seen {
+			names = append(names, name)
+		}
+		uses[name] = append(uses[name], use{tag, a})
+	}
+	for _, a := range spec.GlobalAttributes {
+		add("", a)
+	}
+	for _, e := range spec.Elements {
+		for _, a := range e.Attributes {
+			add(e.Name, a)
+		}
+	}
+
+	typed := func(a spec.Attribute) bool {
+		return len(a.Values) > 0 && (a.Type == spec.String || a.Type == spec.Enum || a.Type == spec.Tokens)
+	}
+	var types []valueType
+	byUse := make(map[[2]string]string)
+	for _, name := range names {
+		shared := true
+		for _, u := range uses[name] {
+			if !typed(u.attr) || strings.Join(u.attr.Values, " ") != strings.Join(uses[name][0].attr.Values, " ") {
+				shared = false
+			}
+		}
+		if shared {
+			vt := valueType{Name: goName(name), Open: uses[name][0].attr.Type == spec.String}
+			var ok bool
+			if vt.Constants, ok = constants(vt.Name, name, uses[name][0].attr.Values); !ok {
+				continue
+			}
+			for _, u := range uses[name] {
+				if !contains(vt.Attrs, u.attr.Name) {
+					vt.Attrs = append(vt.Attrs, u.attr.Name)
+				}
+				byUse[[2]string{u.tag, u.attr.Name}] = vt.Name
+			}
+			types = append(types, vt)
+			continue
+		}
+		for _, u := range uses[name] {
+			if !typed(u.attr) {
+				continue
+			}
+			vt := valueType{Name: goName(name), Attrs: []string{u.attr.Name}, Elem: u.tag, Open: u.attr.Type == spec.String}
+			if u.tag != "" {
+				vt.Name = exported(u.tag) + vt.Name
+			}
+			var ok bool
+			if vt.Constants, ok = constants(vt.Name, name, u.attr.Values); !ok {
+				continue
+			}
+			byUse[[2]string{u.tag, u.attr.Name}] = vt.Name
+			types = append(types, vt)
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	return types, byUse
+}
+
+// methodFor returns the method setting a on the builder of tag.
+func methodFor(tag string, a spec.Attribute, byUse map[[2]string]string) method {
+	m := method{Name: goName(a.Name), Attr: a.Name, Kind: "string", Variadic: a.Type == spec.Tokens}
+	if baseMethods[m.Name] {
+		m.Name += "Attr"
+	}
+	switch a.Type {
+	case spec.Boolean:
+		m.Kind = "bool"
+	case spec.Integer:
+		m.Kind = "int"
+	case spec.Number:
+		m.Kind = "float"
+	}
+	if typ, ok := byUse[[2]string{tag, a.Name}]; ok {
+		m.Kind, m.Type = "value", typ
+	}
+	return m
+}
+
+// typedData collects the value types, the global attribute methods shared
+// by every builder and the builder of each element.
+func typedData() (interface{}, error) {
+	types, byUse := valueTypes()
+
+	// Every package-level name must be unique.
+	decls := map[string]string{"Builder": "the Builder interface", "Elems": "the Elems function"}
+	declare := func(name, what string) error {
+		if other, dup := decls[name]; dup {
+			return fmt.Errorf("%s and %s are both named %s", other, what, name)
+		}
+		decls[name] = what
+		return nil
+	}
+	for _, vt := range types {
+		if err := declare(vt.Name, "type "+vt.Name); err != nil {
+			return nil, err
+		}
+		for _, c := range vt.Constants {
+			if err := declare(c.Name, fmt.Sprintf("keyword %q of %s", c.Value, vt.Name)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var globals []method
+	methods := make(map[string]string)
+	for name := range baseMethods {
+		methods[name] = "a hand-written method"
+	}
+	for _, a := range spec.GlobalAttributes {
+		m := methodFor("", a, byUse)
+		if other, dup := methods[m.Name]; dup {
+			return nil, fmt.Errorf("global attribute %s and %s both map to %s", a.Name, other, m.Name)
+		}
+		methods[m.Name] = "global attribute " + a.Name
+		globals = append(globals, m)
+	}
+
+	var builders []builder
+	for _, e := range spec.Elements {
+		b := builder{
+			Name: exported(e.Name), Func: e.GoName(), Tag: e.Name,
+			Description: e.Description, Void: e.Void, Obsolete: e.Obsolete,
+		}
+		if err := declare(b.Name, "<"+e.Name+">"); err != nil {
+			return nil, err
+		}
+		if err := declare(b.Name+"Builder", "the builder of <"+e.Name+">"); err != nil {
+			return nil, err
+		}
+		own := make(map[string]bool)
+		for _, a := range e.Attributes {
+			m := methodFor(e.Name, a, byUse)
+			if other, dup := methods[m.Name]; dup || own[m.Name] {
+				if !dup {
+					other = "another attribute"
+				}
+				return nil, fmt.Errorf("<%s> attribute %s and %s both map to %s", e.Name, a.Name, other, m.Name)
+			}
+			own[m.Name] = true
+			b.Methods = append(b.Methods, m)
+		}
+		builders = append(builders, b)
+	}
+	return struct {
+		Types    []valueType
+		Globals  []method
+		Builders []builder
+	}{types, globals, builders}, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+var typedTmpl = template.Must(template.New("typed").Funcs(template.FuncMap{
+	"list": func(values []string) string {
+		if len(values) < 2 {
+			return strings.Join(values, "")
+		}
+		return strings.Join(values[:len(values)-1], ", ") + " and " + values[len(values)-1]
+	},
+	"args": func(recv, ret string, m method) map[string]interface{} {
+		return map[string]interface{}{"recv": recv, "ret": ret, "m": m}
+	},
+	"article": func(tag string) string {
+		if strings.ContainsAny(tag[:1], "aeio") {
+			return "an"
+		}
+		return "a"
+	},
+}).Parse(`// Code generated by internal/genhtml from package spec. DO NOT EDIT.
+
+package typed
+
+import "github.com/zulubit/xxhtml/x"
+{{range .Types}}{{$type := .Name}}
+// {{.Name}} is a keyword of the {{list .Attrs}} attribute{{if gt (len .Attrs) 1}}s{{end}}{{with .Elem}} of <{{.}}>{{end}}.
+{{- if .Open}} Other values can be given as {{.Name}}(value).{{end}}
+type {{.Name}} string
+
+const (
+{{- range .Constants}}
+	{{.Name}} {{$type}} = "{{.Value}}"{{end}}
+)
+{{end}}
+{{- define "method"}}
+{{if eq .m.Kind "bool" -}}
+// {{.m.Name}} sets the boolean {{.m.Attr}} attribute.
+func (b {{.recv}}) {{.m.Name}}() {{.ret}} {
+	return b.add(x.AttBool("{{.m.Attr}}", true))
+}
+{{- else if eq .m.Kind "int" -}}
+// {{.m.Name}} sets the {{.m.Attr}} attribute to an integer.
+func (b {{.recv}}) {{.m.Name}}(n int) {{.ret}} {
+	return b.add(x.AttInt("{{.m.Attr}}", n))
+}
+{{- else if eq .m.Kind "float" -}}
+// {{.m.Name}} sets the {{.m.Attr}} attribute to a number.
+func (b {{.recv}}) {{.m.Name}}(n float64) {{.ret}} {
+	return b.add(x.AttFloat("{{.m.Attr}}", n))
+}
+{{- else if .m.Variadic -}}
+// {{.m.Name}} sets the {{.m.Attr}} attribute to values separated by spaces.
+func (b {{.recv}}) {{.m.Name}}(values ...{{or .m.Type "string"}}) {{.ret}} {
+	return b.add(x.Att("{{.m.Attr}}", joinTokens(values)))
+}
+{{- else if eq .m.Kind "value" -}}
+// {{.m.Name}} sets the {{.m.Attr}} attribute.
+func (b {{.recv}}) {{.m.Name}}(value {{.m.Type}}) {{.ret}} {
+	return b.add(x.Att("{{.m.Attr}}", string(value)))
+}
+{{- else -}}
+// {{.m.Name}} sets the {{.m.Attr}} attribute.
+func (b {{.recv}}) {{.m.Name}}(value string) {{.ret}} {
+	return b.add(x.Att("{{.m.Attr}}", value))
+}
+{{- end}}
+{{end}}
+{{- range .Globals}}{{template "method" (args "base[B]" "B" .)}}{{end}}
+{{- range .Builders}}{{$b := .}}
+// {{.Name}}Builder builds {{article .Tag}} <{{.Tag}}> element.
+type {{.Name}}Builder struct{ base[{{.Name}}Builder] }
+
+// {{.Name}} starts {{article .Tag}} <{{.Tag}}>{{with .Description}} ({{.}}){{end}} element
+{{- if .Void}}. This is a self-closing tag, so it takes no children.{{else}} with optional children.{{end}}
+{{- if .Obsolete}}
+//
+// Deprecated: <{{.Tag}}> is obsolete in the HTML standard.
+{{- end}}
+func {{.Name}}({{if not .Void}}children ...x.Elem{{end}}) {{.Name}}Builder {
+	return {{.Name}}Builder{newBase(x.{{.Func}}({{if not .Void}}children...{{end}}), func(b base[{{.Name}}Builder]) {{.Name}}Builder {
+		return {{.Name}}Builder{b}
+	})}
+}
+{{if not .Void}}
+// Children appends children to the element.
+func (b {{.Name}}Builder) Children(children ...x.Elem) {{.Name}}Builder {
+	return b.add(children...)
+}
+{{end}}
+{{- range .Methods}}{{template "method" (args (print $b.Name "Builder") (print $b.Name "Builder") .)}}{{end}}
+{{- end}}`))
@@ -0,0 +1,323 @@
+// Package isr caches rendered pages and regenerates them in the background
+// once they go stale, between a static export and rendering every request.
+package isr
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+// Page is a rendered page held by a Store.
+type Page struct {
+	Body     []byte    // Rendered HTML
+	Rendered time.Time // When the page was rendered
+}
+
+// Store holds rendered pages by path. Implementations must be safe for
+// concurrent use.
+type Store interface {
+	Load(path string) (Page, bool, error)
+	Save(path string, page Page) error
+	Delete(path string) error
+}
+
+// Cache serves pages from a Store, rendering each one on its first request
+// and again in the background once it is older than TTL. Stale pages keep
+// being served while they are regenerated, and concurrent renders of the
+// same path are merged into one. The zero value keeps pages in memory and
+// never lets them go stale.
+type Cache struct {
+	TTL   time.Duration // Age after which a page is regenerated; zero never regenerates
+	Store Store         // Where pages are kept; nil uses an in-memory store
+
+	// OnError builds the error page when a page cannot be rendered and no
+	// cached copy exists. It defaults to x.DefaultErrorPage.
+	OnError func(r *http.Request, err error) (int, x.Elem)
+
+	once     sync.Once
+	mu       sync.Mutex
+	inflight map[string]*render
+	gen      map[string]uint64 // bumped by Invalidate to discard renders in flight
+	now      func() time.Time
+}
+
+// render is a render of one path in flight, shared by every caller asking
+// for it meanwhile.
+type render struct {
+	done chan struct{}
+	gen  uint64 // generation of the path when the render started
+	page Page
+	err  error
+}
+
+func (c *Cache) init() {
+	c.once.Do(func() {
+		if c.Store == nil {
+			c.Store = NewMemoryStore()
+		}
+		if c.now == nil {
+			c.now = time.Now
+		}
+		c.inflight = make(map[string]*render)
+		c.gen = make(map[string]uint64)
+	})
+}
+
+// Handler returns an http.Handler serving the page built by fn from the
+// cache. Pages are keyed by the request path; the query string is not part
+// of the key. Requests other than GET and HEAD bypass the cache.
+func (c *Cache) Handler(fn func(*http.Request) (x.Elem, error)) http.Handler {
+	c.init()
+	onError := c.OnError
+	if onError == nil {
+		onError = x.DefaultErrorPage
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			x.Handler(fn).OnError(onError).ServeHTTP(w, r)
+			return
+		}
+
+		path := r.URL.Path
+		page, ok, err := c.Store.Load(path)
+		if err != nil {
+			log.Printf("isr: loading %s: %v", path, err)
+			ok = false
+		}
+		if !ok {
+			page, err = c.render(path, r, fn)
+			if err != nil {
+				status, errPage := onError(r, err)
+				if status >= http.StatusInternalServerError {
+					log.Printf("isr: rendering %s: %v", path, err)
+				}
+				x.Write(w, status, errPage)
+				return
+			}
+		} else if c.stale(page) {
+			c.refresh(path, r, fn)
+		}
+
+		age := c.now().Sub(page.Rendered)
+		if age < 0 {
+			age = 0
+		}
+		w.Header().Set("Age", strconv.Itoa(int(age.Seconds())))
+		x.Write(w, http.StatusOK, x.CR(string(page.Body)))
+	})
+}
+
+// Invalidate removes the page at path, so the next request renders it
+// again. A render of the path already in flight is not stored.
+func (c *Cache) Invalidate(path string) error {
+	c.init()
+	c.mu.Lock()
+	c.gen[path]++
+	c.mu.Unlock()
+	return c.Store.Delete(path)
+}
+
+func (c *Cache) stale(page Page) bool {
+	return c.TTL > 0 && c.now().Sub(page.Rendered) >= c.TTL
+}
+
+// refresh starts a background render of path unless one is in flight.
+func (c *Cache) refresh(path string, r *http.Request, fn func(*http.Request) (x.Elem, error)) {
+	c.mu.Lock()
+	_, busy := c.inflight[path]
+	gen := c.gen[path]
+	c.mu.Unlock()
+	if busy {
+		return
+	}
+
+	// A render may have finished since the stale page was loaded. The store
+	// is read without c.mu, so a slow store does not hold up other paths.
+	page, ok, err := c.Store.Load(path)
+	fresh := err == nil && ok && !c.stale(page)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, busy := c.inflight[path]; busy {
+		return
+	}
+	// The page loaded is only current if the path was not invalidated since.
+	if fresh && c.gen[path] == gen {
+		return
+	}
+	call := c.start(path)
+	// The render outlives the request that triggered it.
+	r = r.Clone(context.WithoutCancel(r.Context()))
+	go func() {
+		if err := c.run(path, call, r, fn); err != nil {
+			log.Printf("isr: regenerating %s: %v", path, err)
+		}
+	}()
+}
+
+// render renders and stores the page at path, or waits for the render in
+// flight and shares its result.
+func (c *Cache) render(path string, r *http.Request, fn func(*http.Request) (x.Elem, error)) (Page, error) {
+	c.mu.Lock()
+	if call, ok := c.inflight[path]; ok {
+		c.mu.Unlock()
+		<-call.done
+		return call.page, call.err
+	}
+	call := c.start(path)
+	c.mu.Unlock()
+	err := c.run(path, call, r, fn)
+	return call.page, err
+}
+
+// start registers a render of path. The caller must hold c.mu.
+func (c *Cache) start(path string) *render {
+	call := &render{done: make(chan struct{}), gen: c.gen[path]}
+	c.inflight[path] = call
+	return call
+}
+
+// run performs a registered render, stores the page and releases waiters.
+func (c *Cache) run(path string, call *render, r *http.Request, fn func(*http.Request) (x.Elem, error)) error {
+	call.page, call.err = c.build(r, fn)
+
+	c.mu.Lock()
+	if call.err == nil && c.gen[path] == call.gen {
+		if err := c.Store.Save(path, call.page); err != nil {
+			log.Printf("isr: storing %s: %v", path, err)
+		}
+	}
+	delete(c.inflight, path)
+	c.mu.Unlock()
+	close(call.done)
+	return call.err
+}
+
+func (c *Cache) build(r *http.Request, fn func(*http.Request) (x.Elem, error)) (Page, error) {
+	rendered := c.now()
+	elem, err := fn(r)
+	if err != nil {
+		return Page{}, err
+	}
+	var buf bytes.Buffer
+	if err := elem.Render(&buf); err != nil {
+		return Page{}, err
+	}
+	return Page{Body: buf.Bytes(), Rendered: rendered}, nil
+}
+
+// MemoryStore keeps pages in memory.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	pages map[string]Page
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{pages: make(map[string]Page)}
+}
+
+// Load implements Store.
+func (s *MemoryStore) Load(path string) (Page, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	page, ok := s.pages[path]
+	return page, ok, nil
+}
+
+// Save implements Store.
+func (s *MemoryStore) Save(path string, page Page) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pages[path] = page
+	return nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.pages, path)
+	return nil
+}
+
+// DirStore keeps pages as files in a directory, so they survive restarts.
+// Files are named after a hash of the path and carry the render time as
+// their modification time.
+type DirStore struct {
+	Dir string
+}
+
+// NewDirStore returns a DirStore writing to dir, which is created when the
+// first page is saved.
+func NewDirStore(dir string) *DirStore {
+	return &DirStore{Dir: dir}
+}
+
+func (s *DirStore) file(path string) string {
+	sum := sha256.Sum256([]byte(path))
+	return filepath.Join(s.Dir, hex.EncodeToString(sum[:16])+".html")
+}
+
+// Load implements Store.
+func (s *DirStore) Load(path string) (Page, bool, error) {
+	name := s.file(path)
+	body, err := os.ReadFile(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Page{}, false, nil
+	}
+	if err != nil {
+		return Page{}, false, err
+	}
+	info, err := os.Stat(name)
+	if err != nil {
+		return Page{}, false, err
+	}
+	return Page{Body: body, Rendered: info.ModTime()}, true, nil
+}
+
+// Save implements Store. The page is written to a temporary file first, so
+// readers never see a partial page.
+func (s *DirStore) Save(path string, page Page) error {
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.Dir, "page-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(page.Body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chtimes(tmp.Name(), page.Rendered, page.Rendered); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.file(path))
+}
+
+// Delete implements Store.
+func (s *DirStore) Delete(path string) error {
+	err := os.Remove(s.file(path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
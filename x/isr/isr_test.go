@@ -0,0 +1,218 @@
+package isr
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+// clock is a manually advanced time source.
+type clock struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+func (c *clock) now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t
+}
+
+func (c *clock) advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t = c.t.Add(d)
+}
+
+func get(h http.Handler, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
+
+func TestCacheRevalidates(t *testing.T) {
+	clk := &clock{t: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	cache := &Cache{TTL: time.Minute, now: clk.now}
+
+	var renders atomic.Int32
+	release := make(chan struct{}, 1)
+	h := cache.Handler(func(r *http.Request) (x.Elem, error) {
+		n := renders.Add(1)
+		if n > 1 {
+			<-release
+		}
+		return x.P(x.C(n)), nil
+	})
+
+	if body := get(h, "/page").Body.String(); body != "<p>1</p>" {
+		t.Fatalf("expected first render, got %q", body)
+	}
+	clk.advance(30 * time.Second)
+	rec := get(h, "/page")
+	if rec.Body.String() != "<p>1</p>" || rec.Header().Get("Age") != "30" {
+		t.Errorf("expected cached page aged 30s, got %q with Age %q", rec.Body.String(), rec.Header().Get("Age"))
+	}
+
+	// Once stale, the old page is served while a single background render runs.
+	clk.advance(time.Minute)
+	for i := 0; i < 5; i++ {
+		if body := get(h, "/page").Body.String(); body != "<p>1</p>" {
+			t.Errorf("expected stale page, got %q", body)
+		}
+	}
+	release <- struct{}{}
+	deadline := time.Now().Add(time.Second)
+	for get(h, "/page").Body.String() != "<p>2</p>" {
+		if time.Now().After(deadline) {
+			t.Fatal("expected the regenerated page to be served")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if n := renders.Load(); n != 2 {
+		t.Errorf("expected 2 renders, got %d", n)
+	}
+}
+
+// slowStore blocks the second Load after block is called until release is
+// closed, as a store on a slow network would.
+type slowStore struct {
+	*MemoryStore
+	loads   atomic.Int32
+	blocked chan struct{}
+	release chan struct{}
+}
+
+func (s *slowStore) block() {
+	s.loads.Store(0)
+	s.blocked = make(chan struct{})
+	s.release = make(chan struct{})
+}
+
+func (s *slowStore) Load(path string) (Page, bool, error) {
+	if s.release != nil && s.loads.Add(1) == 2 {
+		close(s.blocked)
+		<-s.release
+	}
+	return s.MemoryStore.Load(path)
+}
+
+func TestCacheRefreshDoesNotHoldLock(t *testing.T) {
+	clk := &clock{t: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	store := &slowStore{MemoryStore: NewMemoryStore()}
+	cache := &Cache{Store: store, TTL: time.Minute, now: clk.now}
+	h := cache.Handler(func(r *http.Request) (x.Elem, error) {
+		return x.P(x.C(r.URL.Path)), nil
+	})
+	get(h, "/slow")
+	clk.advance(2 * time.Minute)
+
+	// The stale request loads the page, then refresh loads it again and blocks.
+	store.block()
+	go get(h, "/slow")
+	<-store.blocked
+	defer close(store.release)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if err := cache.Invalidate("/other"); err != nil {
+			t.Errorf("Invalidate() returned an error: %v", err)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Invalidate() blocked on a refresh reading the store")
+	}
+}
+
+func TestCacheMergesRenders(t *testing.T) {
+	cache := &Cache{}
+	var renders atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	h := cache.Handler(func(r *http.Request) (x.Elem, error) {
+		if renders.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		return x.P(x.C("page")), nil
+	})
+
+	var wg sync.WaitGroup
+	bodies := make([]string, 4)
+	for i := range bodies {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			bodies[i] = get(h, "/").Body.String()
+		}(i)
+	}
+	<-started
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for _, body := range bodies {
+		if body != "<p>page</p>" {
+			t.Errorf("expected %q, got %q", "<p>page</p>", body)
+		}
+	}
+	if n := renders.Load(); n != 1 {
+		t.Errorf("expected 1 render, got %d", n)
+	}
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	for name, store := range map[string]Store{
+		"Memory": NewMemoryStore(),
+		"Dir":    NewDirStore(t.TempDir()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			cache := &Cache{Store: store}
+			var renders atomic.Int32
+			h := cache.Handler(func(r *http.Request) (x.Elem, error) {
+				return x.P(x.C(renders.Add(1))), nil
+			})
+
+			get(h, "/a")
+			if body := get(h, "/a").Body.String(); body != "<p>1</p>" {
+				t.Errorf("expected cached page, got %q", body)
+			}
+			if err := cache.Invalidate("/a"); err != nil {
+				t.Fatalf("Invalidate() returned an error: %v", err)
+			}
+			if body := get(h, "/a").Body.String(); body != "<p>2</p>" {
+				t.Errorf("expected a new render after Invalidate, got %q", body)
+			}
+			if err := cache.Invalidate("/missing"); err != nil {
+				t.Errorf("Invalidate() of a missing page returned an error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCacheErrors(t *testing.T) {
+	cache := &Cache{}
+	fail := true
+	h := cache.Handler(func(r *http.Request) (x.Elem, error) {
+		if fail {
+			return x.Elem{}, x.Error(http.StatusNotFound, errors.New("no such page"))
+		}
+		return x.P(x.C("found")), nil
+	})
+
+	if rec := get(h, "/p"); rec.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, rec.Code)
+	}
+	fail = false
+	if body := get(h, "/p").Body.String(); body != "<p>found</p>" {
+		t.Errorf("expected the failed render not to be cached, got %q", body)
+	}
+}
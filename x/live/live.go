@@ -0,0 +1,162 @@
+// Package live serves stateful components over a WebSocket. A component
+// renders an x.Elem and handles events sent by the browser; after each event
+// it is rendered again and the differences are sent as DOM patches.
+package live
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+// Payload holds the values sent with an event: the data-* attributes of the
+// element, its value for form controls, or the fields of a submitted form.
+type Payload map[string]string
+
+// Component is a stateful view. A new component is mounted for every
+// connection, and its methods are never called concurrently. A component
+// implementing io.Closer is closed when the connection ends.
+type Component interface {
+	Render() x.Elem
+	HandleEvent(name string, payload Payload) error
+}
+
+// MountFunc creates the component for a new connection.
+type MountFunc func(r *http.Request) (Component, error)
+
+//go:embed live.js
+var clientScript string
+
+// Script creates the <script> elements of the client, including the patch
+// applier from x.DiffScript. Add it once to pages containing live views.
+func Script() x.Elem {
+	return x.Fragment(
+		x.Script(x.CR(x.DiffScript)),
+		x.Script(x.CR(clientScript)),
+	)
+}
+
+// View creates the container of a component served at the WebSocket
+// endpoint url, holding the component's initial render until it connects.
+func View(url string, initial x.Elem) x.Elem {
+	return x.Div(x.Att("data-live", url), initial)
+}
+
+// On creates an x-on:event attribute, sending the named event to the
+// component when the DOM event fires on the element.
+func On(event, name string) x.Elem {
+	return x.Att("x-on:"+event, name)
+}
+
+// Message types sent to the browser.
+const (
+	MessageRender = "render" // Full HTML of the component
+	MessagePatch  = "patch"  // Patches from the previous render
+	MessageError  = "error"  // Error handling an event, with ErrorMessage
+)
+
+// ErrorMessage is the message of the errors sent to the browser. The error
+// itself is logged on the server, since it may reveal internal details.
+const ErrorMessage = "the event could not be handled"
+
+// ServerMessage is a message sent to the browser.
+type ServerMessage struct {
+	Type    string    `json:"type"`
+	HTML    string    `json:"html,omitempty"`
+	Patches []x.Patch `json:"patches,omitempty"`
+	Message string    `json:"message,omitempty"`
+}
+
+// ClientMessage is an event sent by the browser.
+type ClientMessage struct {
+	Event   string  `json:"event"`
+	Payload Payload `json:"payload"`
+}
+
+// Handler returns the WebSocket endpoint serving the components created by
+// mount. Connections from other origins are rejected.
+func Handler(mount MountFunc) http.Handler {
+	return websocket.Server{
+		Handshake: sameOrigin,
+		Handler: func(ws *websocket.Conn) {
+			serve(ws, mount)
+		},
+	}
+}
+
+// sameOrigin rejects handshakes whose Origin is not the requested host, so
+// other sites cannot drive components with the user's cookies.
+func sameOrigin(config *websocket.Config, r *http.Request) error {
+	origin, err := url.Parse(r.Header.Get("Origin"))
+	if err != nil || origin.Host != r.Host {
+		return fmt.Errorf("origin %q not allowed", r.Header.Get("Origin"))
+	}
+	config.Origin = origin
+	return nil
+}
+
+func serve(ws *websocket.Conn, mount MountFunc) {
+	defer ws.Close()
+
+	c, err := mount(ws.Request())
+	if err != nil {
+		sendError(ws, "mounting", err)
+		return
+	}
+	if closer, ok := c.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	prev := c.Render()
+	var buf bytes.Buffer
+	if err := prev.Render(&buf); err != nil {
+		sendError(ws, "rendering", err)
+		return
+	}
+	if err := websocket.JSON.Send(ws, ServerMessage{Type: MessageRender, HTML: buf.String()}); err != nil {
+		return
+	}
+
+	for {
+		var msg ClientMessage
+		if err := websocket.JSON.Receive(ws, &msg); err != nil {
+			return
+		}
+		if err := c.HandleEvent(msg.Event, msg.Payload); err != nil {
+			if err := sendError(ws, "handling event "+msg.Event, err); err != nil {
+				return
+			}
+			continue
+		}
+
+		next := c.Render()
+		patches, err := x.Diff(prev, next)
+		if err != nil {
+			if err := sendError(ws, "rendering", err); err != nil {
+				return
+			}
+			continue
+		}
+		prev = next
+		if len(patches) == 0 {
+			continue
+		}
+		if err := websocket.JSON.Send(ws, ServerMessage{Type: MessagePatch, Patches: patches}); err != nil {
+			return
+		}
+	}
+}
+
+// sendError logs err and sends the browser an error message without it.
+func sendError(ws *websocket.Conn, what string, err error) error {
+	log.Printf("live: %s %s: %v", ws.Request().URL.Path, what, err)
+	return websocket.JSON.Send(ws, ServerMessage{Type: MessageError, Message: ErrorMessage})
+}
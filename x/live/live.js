@@ -0,0 +1,40 @@
+// Connects every element with a data-live attribute to its component over a
+// WebSocket, sending x-on:* events and applying the patches sent back.
+// Requires applyPatches from x.DiffScript.
+(function () {
+  const events = ["click", "submit", "input", "change"];
+
+  function connect(container) {
+    const url = new URL(container.dataset.live, location.href);
+    url.protocol = url.protocol === "https:" ? "wss:" : "ws:";
+    const ws = new WebSocket(url);
+
+    ws.onmessage = (e) => {
+      const msg = JSON.parse(e.data);
+      if (msg.type === "render") {
+        container.innerHTML = msg.html;
+      } else if (msg.type === "patch") {
+        applyPatches(container, msg.patches);
+      } else if (msg.type === "error") {
+        console.error("live:", msg.message);
+      }
+    };
+
+    for (const type of events) {
+      container.addEventListener(type, (e) => {
+        const el = e.target.closest("[x-on\\:" + type + "]");
+        if (!el || !container.contains(el)) return;
+        if (type === "submit") e.preventDefault();
+        const payload = Object.assign({}, el.dataset);
+        if (el instanceof HTMLFormElement) {
+          new FormData(el).forEach((v, k) => (payload[k] = String(v)));
+        } else if ("value" in el) {
+          payload.value = String(el.value);
+        }
+        ws.send(JSON.stringify({ event: el.getAttribute("x-on:" + type), payload }));
+      });
+    }
+  }
+
+  document.querySelectorAll("[data-live]").forEach(connect);
+})();
@@ -0,0 +1,115 @@
+package live
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+type counter struct {
+	count int
+}
+
+func (c *counter) Render() x.Elem {
+	return x.Div(
+		x.Span(x.C(c.count)),
+		x.Button(On("click", "inc"), x.Att("data-by", "2"), x.C("+")),
+	)
+}
+
+func (c *counter) HandleEvent(name string, payload Payload) error {
+	if name != "inc" {
+		return errors.New("unknown event " + name)
+	}
+	by, err := strconv.Atoi(payload["by"])
+	if err != nil {
+		return err
+	}
+	c.count += by
+	return nil
+}
+
+func dial(t *testing.T, srv *httptest.Server, origin string) *websocket.Conn {
+	t.Helper()
+	ws, err := websocket.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/live", "", origin)
+	if err != nil {
+		t.Fatalf("Dial() returned an error: %v", err)
+	}
+	return ws
+}
+
+func receive(t *testing.T, ws *websocket.Conn) ServerMessage {
+	t.Helper()
+	var msg ServerMessage
+	if err := websocket.JSON.Receive(ws, &msg); err != nil {
+		t.Fatalf("Receive() returned an error: %v", err)
+	}
+	return msg
+}
+
+func TestHandler(t *testing.T) {
+	srv := httptest.NewServer(Handler(func(r *http.Request) (Component, error) {
+		return &counter{}, nil
+	}))
+	defer srv.Close()
+
+	// Each connection holds its own state.
+	first, second := dial(t, srv, srv.URL), dial(t, srv, srv.URL)
+	defer first.Close()
+	defer second.Close()
+
+	msg := receive(t, first)
+	expected := `<div><span>0</span><button x-on:click="inc" data-by="2">+</button></div>`
+	if msg.Type != MessageRender || msg.HTML != expected {
+		t.Fatalf("expected render %q, got %+v", expected, msg)
+	}
+	receive(t, second)
+
+	for i, want := range []string{"2", "4"} {
+		if err := websocket.JSON.Send(first, ClientMessage{Event: "inc", Payload: Payload{"by": "2"}}); err != nil {
+			t.Fatalf("Send() returned an error: %v", err)
+		}
+		msg = receive(t, first)
+		if msg.Type != MessagePatch || len(msg.Patches) != 1 || msg.Patches[0].Op != x.OpSetText || msg.Patches[0].Value != want {
+			t.Fatalf("event %d: expected a text patch to %q, got %+v", i, want, msg)
+		}
+	}
+
+	websocket.JSON.Send(second, ClientMessage{Event: "inc", Payload: Payload{"by": "1"}})
+	if msg = receive(t, second); msg.Patches[0].Value != "1" {
+		t.Errorf("expected the second connection to count from 0, got %+v", msg)
+	}
+
+	var logged bytes.Buffer
+	log.SetOutput(&logged)
+	defer log.SetOutput(os.Stderr)
+	websocket.JSON.Send(first, ClientMessage{Event: "reset"})
+	if msg = receive(t, first); msg.Type != MessageError || msg.Message != ErrorMessage {
+		t.Errorf("expected an error message, got %+v", msg)
+	}
+	if !strings.Contains(logged.String(), "unknown event reset") {
+		t.Errorf("expected the error to be logged, got %q", logged.String())
+	}
+}
+
+func TestHandler_Origin(t *testing.T) {
+	srv := httptest.NewServer(Handler(func(r *http.Request) (Component, error) {
+		return &counter{}, nil
+	}))
+	defer srv.Close()
+
+	_, err := websocket.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/live", "", "http://evil.example")
+	if err == nil {
+		t.Fatal("expected a connection from another origin to be rejected")
+	}
+}
@@ -0,0 +1,229 @@
+// Package router serves x pages from an http.ServeMux, wrapping them in
+// layouts attached at path prefixes.
+package router
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+// RouteFunc builds the page for a request. Route parameters are available
+// through r.PathValue.
+type RouteFunc func(r *http.Request) (x.Elem, error)
+
+// LayoutFunc wraps the page of a route, or the output of a nested layout.
+type LayoutFunc func(r *http.Request, child x.Elem) x.Elem
+
+// Route is a page registered on a Router.
+type Route struct {
+	Pattern string // ServeMux pattern, e.g. "GET /admin/users/{id}"
+	Method  string // Method of the pattern, or "" for any method
+	Path    string // Path of the pattern, e.g. "/admin/users/{id}"
+	Title   string // Title used in breadcrumbs and navigation
+}
+
+// WithTitle sets the title used in breadcrumbs and navigation.
+func (rt *Route) WithTitle(title string) *Route {
+	rt.Title = title
+	return rt
+}
+
+// layout is a layout attached at a path prefix.
+type layout struct {
+	prefix string
+	fn     LayoutFunc
+}
+
+// Router is an http.Handler serving pages wrapped in nested layouts.
+type Router struct {
+	mux     *http.ServeMux
+	mu      sync.RWMutex
+	layouts []layout
+	routes  []*Route
+	byPath  map[string]*Route
+}
+
+// New creates an empty Router.
+func New() *Router {
+	return &Router{
+		mux:    http.NewServeMux(),
+		byPath: make(map[string]*Route),
+	}
+}
+
+// Layout attaches a layout to every route whose path is prefix or lies below
+// it. Layouts of longer prefixes are applied first, so "/admin" wraps a page
+// before "/" wraps the result.
+func (rt *Router) Layout(prefix string, fn LayoutFunc) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	// Requests range over the slice without the lock, so it is replaced
+	// rather than changed in place.
+	layouts := make([]layout, 0, len(rt.layouts)+1)
+	layouts = append(layouts, rt.layouts...)
+	layouts = append(layouts, layout{prefix: strings.TrimSuffix(prefix, "/"), fn: fn})
+	sort.SliceStable(layouts, func(i, j int) bool {
+		return len(layouts[i].prefix) > len(layouts[j].prefix)
+	})
+	rt.layouts = layouts
+}
+
+// Handle registers a page for a ServeMux pattern. The page is wrapped in the
+// layouts of its path and served by x.Handler.
+func (rt *Router) Handle(pattern string, fn RouteFunc) *Route {
+	route := &Route{Pattern: pattern, Path: pattern}
+	if method, path, ok := strings.Cut(pattern, " "); ok {
+		route.Method, route.Path = method, strings.TrimSpace(path)
+	}
+	if i := strings.Index(route.Path, "/"); i > 0 {
+		// Drop the host of the pattern.
+		route.Path = route.Path[i:]
+	}
+
+	page := x.Handler(func(r *http.Request) (x.Elem, error) {
+		elem, err := fn(r)
+		if err != nil {
+			return elem, err
+		}
+		return rt.wrap(r, route, elem), nil
+	})
+	rt.mux.Handle(pattern, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), routeKey{}, match{router: rt, route: route})))
+	}))
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.routes = append(rt.routes, route)
+	rt.byPath[pattern] = route
+	return route
+}
+
+// HandleHTTP registers a plain http.Handler, such as a file server, that is
+// not wrapped in layouts.
+func (rt *Router) HandleHTTP(pattern string, h http.Handler) {
+	rt.mux.Handle(pattern, h)
+}
+
+// ServeHTTP implements http.Handler.
+func (rt *Router) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rt.mux.ServeHTTP(w, r)
+}
+
+// Routes returns the registered pages in registration order.
+func (rt *Router) Routes() []*Route {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+	return append([]*Route(nil), rt.routes...)
+}
+
+// wrap applies the layouts of route to elem, innermost first.
+func (rt *Router) wrap(r *http.Request, route *Route, elem x.Elem) x.Elem {
+	rt.mu.RLock()
+	layouts := rt.layouts
+	rt.mu.RUnlock()
+	for _, l := range layouts {
+		if underPrefix(route.Path, l.prefix) {
+			elem = l.fn(r, elem)
+		}
+	}
+	return elem
+}
+
+// underPrefix reports whether path is prefix or lies below it.
+func underPrefix(path, prefix string) bool {
+	if prefix == "" {
+		return true
+	}
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+type routeKey struct{}
+
+// match is stored in the request context of routed pages.
+type match struct {
+	router *Router
+	route  *Route
+}
+
+// Current returns the route matched for r, or nil if r was not routed by a Router.
+func Current(r *http.Request) *Route {
+	m, _ := r.Context().Value(routeKey{}).(match)
+	return m.route
+}
+
+// IsActive reports whether href is the path of r, or a section containing it.
+func IsActive(r *http.Request, href string) bool {
+	if href == "/" {
+		return r.URL.Path == "/"
+	}
+	return underPrefix(r.URL.Path, strings.TrimSuffix(href, "/"))
+}
+
+// NavLink creates an <a> element to href, marked with class "active" and
+// aria-current="page" when it points at the current page or its section.
+func NavLink(r *http.Request, href string, children ...x.Elem) x.Elem {
+	active := IsActive(r, href)
+	return x.A(
+		x.Att("href", href),
+		x.IF(active, x.Class("active")),
+		x.IF(r.URL.Path == href, x.Att("aria-current", "page")),
+		x.Fragment(children...),
+	)
+}
+
+// Crumb is one entry of a breadcrumb trail.
+type Crumb struct {
+	Title   string // Title of the route
+	Path    string // Request path of the entry
+	Current bool   // Whether the entry is the current page
+}
+
+// Breadcrumbs returns the titled routes matching each ancestor of the request
+// path, from the root down to the current page.
+func Breadcrumbs(r *http.Request) []Crumb {
+	m, ok := r.Context().Value(routeKey{}).(match)
+	if !ok {
+		return nil
+	}
+
+	current := strings.TrimSuffix(r.URL.Path, "/")
+	var paths []string
+	for path := current; path != ""; path = path[:strings.LastIndex(path, "/")] {
+		paths = append([]string{path}, paths...)
+	}
+	paths = append([]string{"/"}, paths...)
+	if current == "" {
+		current = "/"
+	}
+
+	var crumbs []Crumb
+	for _, p := range paths {
+		u := *r.URL
+		u.Path = p
+		_, pattern := m.router.mux.Handler(&http.Request{Method: http.MethodGet, Host: r.Host, URL: &u})
+
+		m.router.mu.RLock()
+		route := m.router.byPath[pattern]
+		m.router.mu.RUnlock()
+		if route == nil || route.Title == "" || !exactMatch(route.Path, p) {
+			continue
+		}
+		crumbs = append(crumbs, Crumb{Title: route.Title, Path: p, Current: p == current})
+	}
+	return crumbs
+}
+
+// exactMatch reports whether a pattern path has as many segments as path,
+// so that subtree patterns such as "/" do not match deeper paths.
+func exactMatch(pattern, path string) bool {
+	if strings.Contains(pattern, "...}") {
+		return false
+	}
+	pattern = strings.TrimSuffix(strings.TrimSuffix(pattern, "{$}"), "/")
+	return strings.Count(pattern, "/") == strings.Count(strings.TrimSuffix(path, "/"), "/")
+}
@@ -0,0 +1,113 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+func newTestRouter() *Router {
+	rt := New()
+	rt.Layout("/", func(r *http.Request, child x.Elem) x.Elem {
+		var crumbs []x.Elem
+		for _, c := range Breadcrumbs(r) {
+			crumbs = append(crumbs, x.Li(x.IF(c.Current, x.Class("current")), x.C(c.Title)))
+		}
+		return x.Body(
+			x.Nav(NavLink(r, "/", x.C("Home")), NavLink(r, "/admin", x.C("Admin"))),
+			x.Ol(crumbs...),
+			child,
+		)
+	})
+	rt.Layout("/admin", func(r *http.Request, child x.Elem) x.Elem {
+		return x.Main(x.Class("admin"), child)
+	})
+	rt.Handle("GET /{$}", func(r *http.Request) (x.Elem, error) {
+		return x.P(x.C("Welcome")), nil
+	}).WithTitle("Home")
+	rt.Handle("GET /admin", func(r *http.Request) (x.Elem, error) {
+		return x.P(x.C("Dashboard")), nil
+	}).WithTitle("Admin")
+	rt.Handle("GET /admin/users/{id}", func(r *http.Request) (x.Elem, error) {
+		return x.P(x.C("User " + r.PathValue("id") + " at " + Current(r).Pattern)), nil
+	}).WithTitle("User")
+	return rt
+}
+
+func TestRouter(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{
+			name:     "Root layout only",
+			path:     "/",
+			expected: `<body><nav><a href="/" class="active" aria-current="page">Home</a><a href="/admin">Admin</a></nav><ol><li class="current">Home</li></ol><p>Welcome</p></body>`,
+		},
+		{
+			name:     "Nested layouts with parameters",
+			path:     "/admin/users/42",
+			expected: `<body><nav><a href="/">Home</a><a href="/admin" class="active">Admin</a></nav><ol><li>Home</li><li>Admin</li><li class="current">User</li></ol><main class="admin"><p>User 42 at GET /admin/users/{id}</p></main></body>`,
+		},
+	}
+
+	rt := newTestRouter()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			rt.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d", rec.Code)
+			}
+			if rec.Body.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, rec.Body.String())
+			}
+		})
+	}
+}
+
+// TestRouter_LayoutWhileServing adds layouts while requests are served; run
+// it with -race.
+func TestRouter_LayoutWhileServing(t *testing.T) {
+	rt := newTestRouter()
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			rec := httptest.NewRecorder()
+			rt.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/users/1", nil))
+			if rec.Code != http.StatusOK {
+				t.Errorf("expected status 200, got %d", rec.Code)
+				return
+			}
+		}
+	}()
+	for i := 0; i < 200; i++ {
+		rt.Layout("/admin/users", func(r *http.Request, child x.Elem) x.Elem { return child })
+	}
+	close(done)
+	wg.Wait()
+}
+
+func TestRouter_Routes(t *testing.T) {
+	var patterns []string
+	for _, r := range newTestRouter().Routes() {
+		patterns = append(patterns, r.Method+" "+r.Path)
+	}
+	expected := []string{"GET /{$}", "GET /admin", "GET /admin/users/{id}"}
+	if !reflect.DeepEqual(patterns, expected) {
+		t.Errorf("expected %v, got %v", expected, patterns)
+	}
+}
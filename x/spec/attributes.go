@@ -0,0 +1,125 @@
+package spec
+
+// GlobalAttributes are allowed on every element. Event handlers such as
+// onclick, custom data-* attributes and the ARIA attributes are not listed;
+// see IsEventHandler and ARIAAttributes.
+var GlobalAttributes = []Attribute{
+	tokens("accesskey"),
+	enum("autocapitalize", "off", "none", "on", "sentences", "words", "characters"),
+	enum("autocorrect", "on", "off"),
+	boolean("autofocus"),
+	tokens("class"),
+	str("contenteditable", "true", "false", "plaintext-only"),
+	enum("dir", "ltr", "rtl", "auto"),
+	enum("draggable", "true", "false"),
+	enum("enterkeyhint", "enter", "done", "go", "next", "previous", "search", "send"),
+	boolean("hidden"),
+	{Name: "id", Type: ID},
+	boolean("inert"),
+	enum("inputmode", "none", "text", "decimal", "numeric", "tel", "search", "email", "url"),
+	str("is"),
+	url("itemid"),
+	tokens("itemprop"),
+	idrefs("itemref"),
+	boolean("itemscope"),
+	{Name: "itemtype", Type: URLList},
+	str("lang"),
+	str("nonce"),
+	str("popover", "auto", "manual", "hint"),
+	tokens("role"),
+	str("slot"),
+	str("spellcheck", "true", "false"),
+	str("style"),
+	integer("tabindex"),
+	str("title"),
+	enum("translate", "yes", "no"),
+	enum("writingsuggestions", "true", "false"),
+}
+
+// ARIAAttributes are the states and properties of WAI-ARIA 1.2.
+var ARIAAttributes = []Attribute{
+	idref("aria-activedescendant"),
+	{Name: "aria-atomic", Type: TrueFalse},
+	enum("aria-autocomplete", "inline", "list", "both", "none"),
+	str("aria-braillelabel"),
+	str("aria-brailleroledescription"),
+	{Name: "aria-busy", Type: TrueFalse},
+	{Name: "aria-checked", Type: Tristate},
+	integer("aria-colcount"),
+	integer("aria-colindex"),
+	str("aria-colindextext"),
+	integer("aria-colspan"),
+	idrefs("aria-controls"),
+	enum("aria-current", "page", "step", "location", "date", "time", "true", "false"),
+	idrefs("aria-describedby"),
+	str("aria-description"),
+	idrefs("aria-details"),
+	{Name: "aria-disabled", Type: TrueFalse},
+	idrefs("aria-errormessage"),
+	{Name: "aria-expanded", Type: TrueFalseUndefined},
+	idrefs("aria-flowto"),
+	enum("aria-haspopup", "false", "true", "menu", "listbox", "tree", "grid", "dialog"),
+	{Name: "aria-hidden", Type: TrueFalseUndefined},
+	enum("aria-invalid", "grammar", "false", "spelling", "true"),
+	str("aria-keyshortcuts"),
+	str("aria-label"),
+	idrefs("aria-labelledby"),
+	integer("aria-level"),
+	enum("aria-live", "assertive", "off", "polite"),
+	{Name: "aria-modal", Type: TrueFalse},
+	{Name: "aria-multiline", Type: TrueFalse},
+	{Name: "aria-multiselectable", Type: TrueFalse},
+	enum("aria-orientation", "horizontal", "vertical", "undefined"),
+	idrefs("aria-owns"),
+	str("aria-placeholder"),
+	integer("aria-posinset"),
+	{Name: "aria-pressed", Type: Tristate},
+	{Name: "aria-readonly", Type: TrueFalse},
+	tokens("aria-relevant", "additions", "all", "removals", "text"),
+	{Name: "aria-required", Type: TrueFalse},
+	str("aria-roledescription"),
+	integer("aria-rowcount"),
+	integer("aria-rowindex"),
+	str("aria-rowindextext"),
+	integer("aria-rowspan"),
+	{Name: "aria-selected", Type: TrueFalseUndefined},
+	integer("aria-setsize"),
+	enum("aria-sort", "ascending", "descending", "none", "other"),
+	number("aria-valuemax"),
+	number("aria-valuemin"),
+	number("aria-valuenow"),
+	str("aria-valuetext"),
+}
+
+func role(name string, required ...string) Role {
+	return Role{Name: name, Required: required}
+}
+
+func unnamed(name string) Role {
+	return Role{Name: name, NamingProhibited: true}
+}
+
+// Roles are the concrete roles of WAI-ARIA 1.2.
+var Roles = []Role{
+	role("alert"), role("alertdialog"), role("application"), role("article"),
+	role("banner"), role("blockquote"), role("button"), unnamed("caption"),
+	role("cell"), role("checkbox", "aria-checked"), unnamed("code"), role("columnheader"),
+	role("combobox", "aria-expanded"), role("comment"), role("complementary"), role("contentinfo"),
+	role("definition"), unnamed("deletion"), role("dialog"), role("document"),
+	unnamed("emphasis"), role("feed"), role("figure"), role("form"),
+	unnamed("generic"), role("grid"), role("gridcell"), role("group"),
+	role("heading", "aria-level"), role("img"), unnamed("insertion"), role("link"),
+	role("list"), role("listbox"), role("listitem"), role("log"),
+	role("main"), role("mark"), role("marquee"), role("math"),
+	role("menu"), role("menubar"), role("menuitem"), role("menuitemcheckbox", "aria-checked"),
+	role("menuitemradio", "aria-checked"), role("meter", "aria-valuenow"), role("navigation"), unnamed("none"),
+	role("note"), role("option"), unnamed("paragraph"), unnamed("presentation"),
+	role("progressbar"), role("radio", "aria-checked"), role("radiogroup"), role("region"),
+	role("row"), role("rowgroup"), role("rowheader"), role("scrollbar", "aria-controls", "aria-valuenow"),
+	role("search"), role("searchbox"), role("separator"), role("slider", "aria-valuenow"),
+	role("spinbutton"), role("status"), unnamed("strong"), unnamed("subscript"),
+	role("suggestion"), unnamed("superscript"), role("switch", "aria-checked"), role("tab"),
+	role("table"), role("tablist"), role("tabpanel"), role("term"),
+	role("textbox"), role("time"), role("timer"), role("toolbar"),
+	role("tooltip"), role("tree"), role("treegrid"), role("treeitem"),
+}
@@ -0,0 +1,279 @@
+package spec
+
+var (
+	flowContent     = Content{Categories: Flow}
+	phrasingContent = Content{Categories: Phrasing}
+	textContent     = Content{Kind: Text}
+	noContent       = Content{Kind: Nothing}
+	transparent     = Content{Kind: Transparent}
+	foreign         = Content{Kind: Foreign}
+	listContent     = Content{Categories: ScriptSupporting, Tags: []string{"li"}}
+	rowsContent     = Content{Categories: ScriptSupporting, Tags: []string{"tr"}}
+
+	// flowNoLandmarks is flow content without header, footer, sectioning
+	// or heading content, used by dt and th.
+	flowNoLandmarks = Content{Categories: Flow, Exclude: Sectioning | Heading, ExcludeTags: []string{"header", "footer"}}
+)
+
+func str(name string, keywords ...string) Attribute {
+	return Attribute{Name: name, Type: String, Values: keywords}
+}
+
+func boolean(name string) Attribute { return Attribute{Name: name, Type: Boolean} }
+func integer(name string) Attribute { return Attribute{Name: name, Type: Integer} }
+func number(name string) Attribute  { return Attribute{Name: name, Type: Number} }
+func url(name string) Attribute     { return Attribute{Name: name, Type: URL} }
+func idref(name string) Attribute   { return Attribute{Name: name, Type: IDRef} }
+func idrefs(name string) Attribute  { return Attribute{Name: name, Type: IDRefs} }
+
+func enum(name string, values ...string) Attribute {
+	return Attribute{Name: name, Type: Enum, Values: values}
+}
+
+func tokens(name string, values ...string) Attribute {
+	return Attribute{Name: name, Type: Tokens, Values: values}
+}
+
+func join(lists ...[]Attribute) []Attribute {
+	var all []Attribute
+	for _, l := range lists {
+		all = append(all, l...)
+	}
+	return all
+}
+
+func phrasing(description string, attrs ...Attribute) Element {
+	return Element{Description: description, Categories: Flow | Phrasing, Content: phrasingContent, Attributes: attrs}
+}
+
+func flowSectioning(description string) Element {
+	return Element{Description: description, Categories: Flow | Sectioning, Content: flowContent}
+}
+
+func heading(level string) Element {
+	return Element{Description: "level " + level + " heading", Categories: Flow | Heading, Content: phrasingContent}
+}
+
+func void(description string, categories Category, attrs ...Attribute) Element {
+	return Element{Description: description, Categories: categories, Content: noContent, Attributes: attrs, Void: true}
+}
+
+func named(name string, e Element) Element {
+	e.Name = name
+	return e
+}
+
+// Keyword lists shared by several attributes.
+var (
+	// InputTypes are the values of the type attribute of input.
+	InputTypes = []string{"button", "checkbox", "color", "date", "datetime-local", "email", "file", "hidden", "image", "month", "number", "password", "radio", "range", "reset", "search", "submit", "tel", "text", "time", "url", "week"}
+
+	// LinkTypes are the values of the rel attribute.
+	LinkTypes = []string{"alternate", "author", "bookmark", "canonical", "dns-prefetch", "expect", "external", "help", "icon", "license", "manifest", "me", "modulepreload", "next", "nofollow", "noopener", "noreferrer", "opener", "pingback", "preconnect", "prefetch", "preload", "prev", "privacy-policy", "search", "stylesheet", "tag", "terms-of-service"}
+
+	// Targets are the keywords of the target attribute, which also accepts
+	// the name of a window or frame.
+	Targets = []string{"_blank", "_self", "_parent", "_top"}
+
+	// ReferrerPolicies are the values of the referrerpolicy attribute.
+	ReferrerPolicies = []string{"no-referrer", "no-referrer-when-downgrade", "same-origin", "origin", "strict-origin", "origin-when-cross-origin", "strict-origin-when-cross-origin", "unsafe-url"}
+
+	// SandboxTokens are the values of the sandbox attribute of iframe.
+	SandboxTokens = []string{"allow-downloads", "allow-forms", "allow-modals", "allow-orientation-lock", "allow-pointer-lock", "allow-popups", "allow-popups-to-escape-sandbox", "allow-presentation", "allow-same-origin", "allow-scripts", "allow-top-navigation", "allow-top-navigation-by-user-activation", "allow-top-navigation-to-custom-protocols"}
+
+	// EncodingTypes are the values of the enctype attribute of form.
+	EncodingTypes = []string{"application/x-www-form-urlencoded", "multipart/form-data", "text/plain"}
+)
+
+var (
+	crossorigin    = enum("crossorigin", "anonymous", "use-credentials")
+	referrerpolicy = enum("referrerpolicy", ReferrerPolicies...)
+	fetchpriority  = enum("fetchpriority", "high", "low", "auto")
+	blocking       = tokens("blocking", "render")
+	target         = str("target", Targets...)
+	width          = integer("width")
+	height         = integer("height")
+	form           = idref("form")
+	nameAttr       = str("name")
+	disabled       = boolean("disabled")
+	popovertarget  = []Attribute{idref("popovertarget"), enum("popovertargetaction", "toggle", "show", "hide")}
+
+	hyperlinkAttrs = []Attribute{url("href"), target, str("download"), Attribute{Name: "ping", Type: URLList}, tokens("rel", LinkTypes...), str("hreflang"), str("type"), referrerpolicy}
+	mediaAttrs     = []Attribute{url("src"), crossorigin, enum("preload", "none", "metadata", "auto"), boolean("autoplay"), boolean("loop"), boolean("muted"), boolean("controls")}
+	editAttrs      = []Attribute{url("cite"), {Name: "datetime", Type: DateTime}}
+	cellAttrs      = []Attribute{integer("colspan"), integer("rowspan"), idrefs("headers")}
+	formAttrs      = []Attribute{url("formaction"), enum("formenctype", EncodingTypes...), enum("formmethod", "get", "post", "dialog"), boolean("formnovalidate"), str("formtarget", Targets...)}
+	textAttrs      = []Attribute{str("autocomplete", "on", "off"), str("dirname"), disabled, form, integer("maxlength"), integer("minlength"), nameAttr, str("placeholder"), boolean("readonly"), boolean("required")}
+)
+
+// Elements lists the elements of the HTML living standard in the order the
+// standard defines them, followed by obsolete elements still in use.
+var Elements = []Element{
+	// The document element and metadata
+	named("html", Element{Description: "root", Content: Content{Tags: []string{"head", "body"}}, Required: []string{"head", "body"}, Attributes: []Attribute{str("xmlns")}}),
+	named("head", Element{Description: "document metadata", Content: Content{Categories: Metadata}, Parents: []string{"html"}, Required: []string{"title"}}),
+	named("title", Element{Description: "document title", Categories: Metadata, Content: textContent, Parents: []string{"head"}, Text: EscapableRawText}),
+	named("base", void("document base URL", Metadata, url("href"), target)),
+	named("link", void("external resource link", Metadata|Flow|Phrasing,
+		url("href"), crossorigin, tokens("rel", LinkTypes...),
+		enum("as", "fetch", "audio", "document", "embed", "font", "image", "json", "object", "script", "style", "track", "video", "worker"),
+		str("media"), str("integrity"), str("hreflang"), str("type"), referrerpolicy, tokens("sizes", "any"),
+		str("imagesrcset"), str("imagesizes"), blocking, str("color"), disabled, fetchpriority)),
+	named("meta", void("metadata", Metadata|Flow|Phrasing,
+		str("name", "application-name", "author", "color-scheme", "description", "generator", "keywords", "referrer", "theme-color", "viewport"),
+		enum("http-equiv", "content-language", "content-type", "default-style", "refresh", "set-cookie", "x-ua-compatible", "content-security-policy"),
+		str("content"), enum("charset", "utf-8"), str("media"))),
+	named("style", Element{Description: "style information", Categories: Metadata, Content: textContent, Attributes: []Attribute{str("media"), blocking}, Text: RawText}),
+
+	// Sections
+	named("body", Element{Description: "document body", Content: flowContent, Parents: []string{"html"}}),
+	named("article", flowSectioning("")),
+	named("section", flowSectioning("")),
+	named("nav", flowSectioning("navigation section")),
+	named("aside", flowSectioning("")),
+	named("h1", heading("1")),
+	named("h2", heading("2")),
+	named("h3", heading("3")),
+	named("h4", heading("4")),
+	named("h5", heading("5")),
+	named("h6", heading("6")),
+	named("hgroup", Element{Description: "heading group", Categories: Flow | Heading, Content: Content{Categories: ScriptSupporting, Tags: []string{"h1", "h2", "h3", "h4", "h5", "h6", "p"}}}),
+	named("header", Element{Categories: Flow, Content: Content{Categories: Flow, ExcludeTags: []string{"header", "footer"}}}),
+	named("footer", Element{Categories: Flow, Content: Content{Categories: Flow, ExcludeTags: []string{"header", "footer"}}}),
+	named("address", Element{Description: "contact information", Categories: Flow, Content: Content{Categories: Flow, Exclude: Sectioning | Heading, ExcludeTags: []string{"header", "footer", "address"}}}),
+
+	// Grouping content
+	named("p", Element{Description: "paragraph", Categories: Flow, Content: phrasingContent}),
+	named("hr", void("thematic break", Flow)),
+	named("pre", Element{Description: "preformatted text", Categories: Flow, Content: phrasingContent}),
+	named("blockquote", Element{Description: "block quotation", Categories: Flow, Content: flowContent, Attributes: []Attribute{url("cite")}}),
+	named("ol", Element{Description: "ordered list", Categories: Flow, Content: listContent, Attributes: []Attribute{boolean("reversed"), integer("start"), enum("type", "1", "a", "A", "i", "I")}}),
+	named("ul", Element{Description: "unordered list", Categories: Flow, Content: listContent}),
+	named("menu", Element{Description: "toolbar menu", Categories: Flow, Content: listContent}),
+	named("li", Element{Description: "list item", Content: flowContent, Parents: []string{"ol", "ul", "menu"}, Attributes: []Attribute{integer("value")}}),
+	named("dl", Element{Description: "description list", Categories: Flow, Content: Content{Categories: ScriptSupporting, Tags: []string{"dt", "dd", "div"}}}),
+	named("dt", Element{Description: "description term", Content: flowNoLandmarks, Parents: []string{"dl", "div"}}),
+	named("dd", Element{Description: "description details", Content: flowContent, Parents: []string{"dl", "div"}}),
+	named("figure", Element{Categories: Flow, Content: Content{Categories: Flow, Tags: []string{"figcaption"}}}),
+	named("figcaption", Element{Description: "figure caption", Content: flowContent, Parents: []string{"figure"}}),
+	named("main", Element{Description: "main content", Categories: Flow, Content: flowContent}),
+	named("search", Element{Description: "search section", Categories: Flow, Content: flowContent}),
+	named("div", Element{Description: "content division", Categories: Flow, Content: flowContent}),
+
+	// Text-level semantics
+	named("a", Element{Description: "anchor", Categories: Flow | Phrasing, Content: Content{Kind: Transparent, Exclude: Interactive, ExcludeTags: []string{"a"}}, Attributes: hyperlinkAttrs}),
+	named("em", phrasing("emphasis")),
+	named("strong", phrasing("strong importance")),
+	named("small", phrasing("side comment")),
+	named("s", phrasing("strikethrough")),
+	named("cite", phrasing("citation")),
+	named("q", phrasing("inline quotation", url("cite"))),
+	named("dfn", Element{Description: "definition", Categories: Flow | Phrasing, Content: Content{Categories: Phrasing, ExcludeTags: []string{"dfn"}}}),
+	named("abbr", phrasing("abbreviation")),
+	named("ruby", Element{Description: "ruby annotation", Categories: Flow | Phrasing, Content: Content{Categories: Phrasing, Tags: []string{"rt", "rp"}}}),
+	named("rt", Element{Description: "ruby text", Content: phrasingContent, Parents: []string{"ruby"}}),
+	named("rp", Element{Description: "ruby parenthesis", Content: textContent, Parents: []string{"ruby"}}),
+	named("data", Element{Description: "machine-readable data", Func: "DataElem", Categories: Flow | Phrasing, Content: phrasingContent, Attributes: []Attribute{str("value")}}),
+	named("time", phrasing("", Attribute{Name: "datetime", Type: DateTime})),
+	named("code", phrasing("")),
+	named("var", phrasing("variable")),
+	named("samp", phrasing("sample output")),
+	named("kbd", phrasing("keyboard input")),
+	named("sub", phrasing("subscript")),
+	named("sup", phrasing("superscript")),
+	named("i", phrasing("idiomatic text")),
+	named("b", phrasing("bring attention")),
+	named("u", phrasing("unarticulated annotation")),
+	named("mark", phrasing("highlight")),
+	named("bdi", phrasing("bidirectional isolate")),
+	named("bdo", phrasing("bidirectional override")),
+	named("span", phrasing("")),
+	named("br", void("line break", Flow|Phrasing)),
+	named("wbr", void("line break opportunity", Flow|Phrasing)),
+
+	// Edits
+	named("ins", Element{Description: "inserted text", Categories: Flow | Phrasing, Content: transparent, Attributes: editAttrs}),
+	named("del", Element{Description: "deleted text", Categories: Flow | Phrasing, Content: transparent, Attributes: editAttrs}),
+
+	// Embedded content
+	named("picture", Element{Categories: Flow | Phrasing | Embedded, Content: Content{Categories: ScriptSupporting, Tags: []string{"source", "img"}}, Required: []string{"img"}}),
+	named("source", Element{Description: "media source", Content: noContent, Void: true, Parents: []string{"picture", "audio", "video"},
+		Attributes: []Attribute{str("type"), str("media"), url("src"), str("srcset"), str("sizes"), width, height}}),
+	named("img", void("image", Flow|Phrasing|Embedded,
+		str("alt"), url("src"), str("srcset"), str("sizes"), crossorigin, str("usemap"), boolean("ismap"), width, height,
+		referrerpolicy, enum("decoding", "sync", "async", "auto"), enum("loading", "lazy", "eager"), fetchpriority)),
+	named("iframe", Element{Description: "inline frame", Categories: Flow | Phrasing | Embedded | Interactive, Content: noContent,
+		Attributes: []Attribute{url("src"), str("srcdoc"), nameAttr, tokens("sandbox", SandboxTokens...), str("allow"), boolean("allowfullscreen"), width, height, referrerpolicy, enum("loading", "lazy", "eager")}}),
+	named("embed", void("embedded content", Flow|Phrasing|Embedded|Interactive, url("src"), str("type"), width, height)),
+	named("object", Element{Description: "external object", Categories: Flow | Phrasing | Embedded, Content: transparent, Attributes: []Attribute{url("data"), str("type"), nameAttr, form, width, height}}),
+	named("video", Element{Categories: Flow | Phrasing | Embedded, Content: Content{Kind: Transparent, Tags: []string{"source", "track"}, ExcludeTags: []string{"audio", "video"}},
+		Attributes: join(mediaAttrs, []Attribute{url("poster"), boolean("playsinline"), width, height})}),
+	named("audio", Element{Categories: Flow | Phrasing | Embedded, Content: Content{Kind: Transparent, Tags: []string{"source", "track"}, ExcludeTags: []string{"audio", "video"}}, Attributes: mediaAttrs}),
+	named("track", Element{Description: "text track", Content: noContent, Void: true, Parents: []string{"audio", "video"},
+		Attributes: []Attribute{boolean("default"), enum("kind", "subtitles", "captions", "descriptions", "chapters", "metadata"), str("label"), url("src"), str("srclang")}}),
+	named("map", Element{Description: "image map", Categories: Flow | Phrasing, Content: Content{Kind: Transparent, Tags: []string{"area"}}, Attributes: []Attribute{nameAttr}}),
+	named("area", void("image map area", Flow|Phrasing,
+		join([]Attribute{str("alt"), str("coords"), enum("shape", "circle", "default", "poly", "rect")}, hyperlinkAttrs)...)),
+	named("math", Element{Description: "MathML math", Categories: Flow | Phrasing | Embedded, Content: foreign}),
+	named("svg", Element{Description: "SVG graphics", Categories: Flow | Phrasing | Embedded, Content: foreign}),
+
+	// Tabular data
+	named("table", Element{Categories: Flow, Content: Content{Categories: ScriptSupporting, Tags: []string{"caption", "colgroup", "thead", "tbody", "tfoot", "tr"}}}),
+	named("caption", Element{Description: "table caption", Content: Content{Categories: Flow, ExcludeTags: []string{"table"}}, Parents: []string{"table"}}),
+	named("colgroup", Element{Description: "table column group", Content: Content{Tags: []string{"col", "template"}}, Parents: []string{"table"}, Attributes: []Attribute{integer("span")}}),
+	named("col", Element{Description: "table column", Content: noContent, Void: true, Parents: []string{"colgroup"}, Attributes: []Attribute{integer("span")}}),
+	named("tbody", Element{Description: "table body", Content: rowsContent, Parents: []string{"table"}}),
+	named("thead", Element{Description: "table head", Content: rowsContent, Parents: []string{"table"}}),
+	named("tfoot", Element{Description: "table foot", Content: rowsContent, Parents: []string{"table"}}),
+	named("tr", Element{Description: "table row", Content: Content{Categories: ScriptSupporting, Tags: []string{"td", "th"}}, Parents: []string{"table", "thead", "tbody", "tfoot"}}),
+	named("td", Element{Description: "table cell", Content: flowContent, Parents: []string{"tr"}, Attributes: cellAttrs}),
+	named("th", Element{Description: "table header cell", Content: flowNoLandmarks, Parents: []string{"tr"},
+		Attributes: join(cellAttrs, []Attribute{enum("scope", "row", "col", "rowgroup", "colgroup"), str("abbr")})}),
+
+	// Forms
+	named("form", Element{Categories: Flow, Content: Content{Categories: Flow, ExcludeTags: []string{"form"}},
+		Attributes: []Attribute{str("accept-charset", "utf-8"), url("action"), enum("autocomplete", "on", "off"), enum("enctype", EncodingTypes...),
+			enum("method", "get", "post", "dialog"), nameAttr, boolean("novalidate"), tokens("rel", LinkTypes...), target}}),
+	named("label", Element{Categories: Flow | Phrasing | Interactive, Content: Content{Categories: Phrasing, ExcludeTags: []string{"label"}}, Attributes: []Attribute{idref("for")}}),
+	named("input", void("", Flow|Phrasing|Interactive, join(formAttrs, textAttrs, popovertarget, []Attribute{
+		str("accept"), boolean("alpha"), str("alt"), boolean("checked"), enum("colorspace", "limited-srgb", "display-p3"), height,
+		idref("list"), str("max"), str("min"), boolean("multiple"), str("pattern"), integer("size"), url("src"), str("step", "any"),
+		enum("type", InputTypes...), str("value"), width,
+	})...)),
+	named("button", Element{Categories: Flow | Phrasing | Interactive, Content: Content{Categories: Phrasing, Exclude: Interactive},
+		Attributes: join(formAttrs, popovertarget, []Attribute{
+			str("command", "toggle-popover", "show-popover", "hide-popover", "close", "request-close", "show-modal"), idref("commandfor"),
+			disabled, form, nameAttr, enum("type", "submit", "reset", "button"), str("value"),
+		})}),
+	named("select", Element{Categories: Flow | Phrasing | Interactive, Content: Content{Categories: ScriptSupporting, Tags: []string{"option", "optgroup", "hr"}},
+		Attributes: []Attribute{str("autocomplete", "on", "off"), disabled, form, boolean("multiple"), nameAttr, boolean("required"), integer("size")}}),
+	named("datalist", Element{Categories: Flow | Phrasing, Content: Content{Categories: Phrasing | ScriptSupporting, Tags: []string{"option"}}}),
+	named("optgroup", Element{Description: "option group", Content: Content{Categories: ScriptSupporting, Tags: []string{"option", "legend"}}, Parents: []string{"select"}, Attributes: []Attribute{disabled, str("label")}}),
+	named("option", Element{Content: textContent, Parents: []string{"select", "datalist", "optgroup"}, Attributes: []Attribute{disabled, str("label"), boolean("selected"), str("value")}}),
+	named("textarea", Element{Description: "multi-line text input", Categories: Flow | Phrasing | Interactive, Content: textContent, Text: EscapableRawText,
+		Attributes: join(textAttrs, []Attribute{integer("cols"), integer("rows"), enum("wrap", "soft", "hard")})}),
+	named("output", phrasing("", idrefs("for"), form, nameAttr)),
+	named("progress", Element{Description: "progress indicator", Categories: Flow | Phrasing, Content: Content{Categories: Phrasing, ExcludeTags: []string{"progress"}}, Attributes: []Attribute{number("value"), number("max")}}),
+	named("meter", Element{Description: "gauge", Categories: Flow | Phrasing, Content: Content{Categories: Phrasing, ExcludeTags: []string{"meter"}},
+		Attributes: []Attribute{number("value"), number("min"), number("max"), number("low"), number("high"), number("optimum")}}),
+	named("fieldset", Element{Description: "form control group", Categories: Flow, Content: Content{Categories: Flow, Tags: []string{"legend"}}, Attributes: []Attribute{disabled, form, nameAttr}}),
+	named("legend", Element{Description: "fieldset caption", Content: Content{Categories: Phrasing | Heading}, Parents: []string{"fieldset", "optgroup"}}),
+
+	// Interactive elements
+	named("details", Element{Description: "disclosure widget", Categories: Flow | Interactive, Content: Content{Categories: Flow, Tags: []string{"summary"}}, Attributes: []Attribute{nameAttr, boolean("open")}}),
+	named("summary", Element{Description: "disclosure summary", Content: Content{Categories: Phrasing | Heading}, Parents: []string{"details"}}),
+	named("dialog", Element{Categories: Flow, Content: flowContent, Attributes: []Attribute{boolean("open"), enum("closedby", "any", "closerequest", "none")}}),
+
+	// Scripting
+	named("script", Element{Categories: Metadata | Flow | Phrasing | ScriptSupporting, Content: textContent, Text: RawText,
+		Attributes: []Attribute{url("src"), str("type", "module", "importmap", "speculationrules"), boolean("nomodule"), boolean("async"), boolean("defer"),
+			blocking, crossorigin, str("integrity"), referrerpolicy, fetchpriority}}),
+	named("noscript", Element{Description: "scripting fallback", Categories: Metadata | Flow | Phrasing, Content: Content{Kind: Transparent, ExcludeTags: []string{"noscript"}}}),
+	named("template", Element{Description: "content template", Categories: Metadata | Flow | Phrasing | ScriptSupporting, Content: foreign,
+		Attributes: []Attribute{enum("shadowrootmode", "open", "closed"), boolean("shadowrootdelegatesfocus"), boolean("shadowrootclonable"), boolean("shadowrootserializable")}}),
+	named("slot", Element{Description: "shadow tree slot", Categories: Flow | Phrasing, Content: transparent, Attributes: []Attribute{nameAttr}}),
+	named("canvas", Element{Description: "graphics canvas", Categories: Flow | Phrasing | Embedded, Content: transparent, Attributes: []Attribute{width, height}}),
+
+	// Obsolete elements
+	named("param", Element{Description: "object parameter", Content: noContent, Void: true, Parents: []string{"object"}, Attributes: []Attribute{nameAttr, str("value")}, Obsolete: true}),
+}
@@ -0,0 +1,255 @@
+// Package spec is a machine-readable model of the HTML living standard: its
+// elements with their content categories, content models, void and raw text
+// status and attributes, plus the global attributes and the roles, states
+// and properties of WAI-ARIA. Validation, the accessibility checker, the
+// converters and the generated constructors of package x all read it.
+package spec
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Category is a set of HTML content categories.
+type Category uint16
+
+const (
+	Metadata Category = 1 << iota
+	Flow
+	Sectioning
+	Heading
+	Phrasing
+	Embedded
+	Interactive
+	ScriptSupporting
+)
+
+var categoryNames = []string{"metadata", "flow", "sectioning", "heading", "phrasing", "embedded", "interactive", "script-supporting"}
+
+// Has reports whether c includes any category of o.
+func (c Category) Has(o Category) bool {
+	return c&o != 0
+}
+
+// String returns the category names joined by "|", such as "flow|phrasing".
+func (c Category) String() string {
+	var names []string
+	for i, name := range categoryNames {
+		if c&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// ContentKind is the shape of a content model.
+type ContentKind int
+
+const (
+	Categorized ContentKind = iota // Elements of the given categories and tags; text with flow or phrasing
+	Nothing                        // No content at all
+	Text                           // Text only
+	Transparent                    // Whatever the parent allows, plus the given tags
+	Foreign                        // SVG, MathML or template contents, not modelled
+)
+
+// Content is the content model of an element: what it may contain.
+type Content struct {
+	Kind        ContentKind
+	Categories  Category // Allowed child categories
+	Tags        []string // Allowed child tags besides the categories
+	Exclude     Category // Categories not allowed among descendants
+	ExcludeTags []string // Tags not allowed among descendants
+}
+
+// AllowsText reports whether text may appear in the content.
+func (c Content) AllowsText() bool {
+	switch c.Kind {
+	case Text, Foreign:
+		return true
+	case Categorized:
+		return c.Categories.Has(Flow | Phrasing)
+	}
+	return false
+}
+
+// Allows reports whether a child element with the given tag and categories
+// fits a Categorized content model. Other kinds are resolved by the caller:
+// Nothing and Text allow no elements, Foreign allows any, and Transparent
+// defers to the parent.
+func (c Content) Allows(tag string, categories Category) bool {
+	switch c.Kind {
+	case Categorized:
+		return categories.Has(c.Categories) || contains(c.Tags, tag)
+	case Foreign:
+		return true
+	}
+	return false
+}
+
+// TextKind tells how the parser treats the text inside an element.
+type TextKind int
+
+const (
+	NormalText       TextKind = iota // Text and markup
+	RawText                          // Text up to the end tag, with no character references (script, style)
+	EscapableRawText                 // Text with character references but no markup (textarea, title)
+)
+
+// Element describes an HTML element.
+type Element struct {
+	Name        string      // Tag name
+	Description string      // Short description, such as "unordered list"
+	Func        string      // Name of the constructor in package x, when it is not the capitalized tag
+	Categories  Category    // Categories the element belongs to, without those that depend on attributes
+	Content     Content     // What the element may contain
+	Parents     []string    // The element must be a child of one of these
+	Required    []string    // Children the element must have
+	Attributes  []Attribute // Attributes besides the global ones
+	Void        bool        // Has no end tag and no content
+	Text        TextKind    // How text content is parsed
+	Obsolete    bool        // No longer part of the standard
+}
+
+// GoName returns the name of the element's constructor in package x: Func
+// when set, otherwise the tag with its first letter capitalized, such as
+// "Figcaption" or "H1".
+func (e Element) GoName() string {
+	if e.Func != "" {
+		return e.Func
+	}
+	r := []rune(e.Name)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// Attribute returns the definition of an attribute of the element, which
+// may be a global attribute.
+func (e Element) Attribute(name string) (Attribute, bool) {
+	for _, a := range e.Attributes {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return LookupGlobal(name)
+}
+
+// ValueType is the type of an attribute value.
+type ValueType int
+
+const (
+	String             ValueType = iota // Any text; Values lists common keywords, if any
+	Boolean                             // Present or absent; the value is ignored
+	Enum                                // One of Values
+	Integer                             // A valid integer
+	Number                              // A valid floating-point number
+	URL                                 // A URL
+	URLList                             // Space-separated URLs
+	ID                                  // A unique element id
+	IDRef                               // The id of another element
+	IDRefs                              // Space-separated element ids
+	Tokens                              // Space-separated tokens, from Values when set
+	DateTime                            // A date, time or duration
+	TrueFalse                           // "true" or "false"
+	Tristate                            // "true", "false" or "mixed"
+	TrueFalseUndefined                  // "true", "false" or "undefined"
+)
+
+var valueTypeNames = []string{"string", "boolean", "enum", "integer", "number", "url", "url list", "id", "idref", "idrefs", "tokens", "datetime", "true/false", "tristate", "true/false/undefined"}
+
+func (t ValueType) String() string {
+	if int(t) < len(valueTypeNames) {
+		return valueTypeNames[t]
+	}
+	return "ValueType(" + strconv.Itoa(int(t)) + ")"
+}
+
+// Attribute describes an attribute and its value.
+type Attribute struct {
+	Name   string
+	Type   ValueType
+	Values []string // Allowed values for Enum and Tokens, known keywords for String
+}
+
+// Role describes a WAI-ARIA role.
+type Role struct {
+	Name             string
+	Required         []string // States and properties the role requires
+	NamingProhibited bool     // The role cannot be named with aria-label or aria-labelledby
+}
+
+var (
+	elementIndex = index(Elements, func(e Element) string { return e.Name })
+	globalIndex  = index(GlobalAttributes, func(a Attribute) string { return a.Name })
+	ariaIndex    = index(ARIAAttributes, func(a Attribute) string { return a.Name })
+	roleIndex    = index(Roles, func(r Role) string { return r.Name })
+)
+
+func index[T any](list []T, key func(T) string) map[string]int {
+	m := make(map[string]int, len(list))
+	for i, v := range list {
+		m[key(v)] = i
+	}
+	return m
+}
+
+// Lookup returns the element with the given lower-case tag name.
+func Lookup(name string) (Element, bool) {
+	i, ok := elementIndex[name]
+	if !ok {
+		return Element{}, false
+	}
+	return Elements[i], true
+}
+
+// LookupGlobal returns the global attribute with the given name.
+func LookupGlobal(name string) (Attribute, bool) {
+	i, ok := globalIndex[name]
+	if !ok {
+		return Attribute{}, false
+	}
+	return GlobalAttributes[i], true
+}
+
+// LookupARIA returns the WAI-ARIA state or property with the given name,
+// such as "aria-expanded".
+func LookupARIA(name string) (Attribute, bool) {
+	i, ok := ariaIndex[name]
+	if !ok {
+		return Attribute{}, false
+	}
+	return ARIAAttributes[i], true
+}
+
+// LookupRole returns the WAI-ARIA role with the given name.
+func LookupRole(name string) (Role, bool) {
+	i, ok := roleIndex[name]
+	if !ok {
+		return Role{}, false
+	}
+	return Roles[i], true
+}
+
+// IsEventHandler reports whether name is an event handler content
+// attribute, such as "onclick", which every element accepts.
+func IsEventHandler(name string) bool {
+	if !strings.HasPrefix(name, "on") || len(name) == 2 {
+		return false
+	}
+	for _, r := range name[2:] {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package spec
+
+import "testing"
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name     string
+		tag      string
+		found    bool
+		goName   string
+		void     bool
+		text     TextKind
+		obsolete bool
+	}{
+		{name: "Void element", tag: "img", found: true, goName: "Img", void: true},
+		{name: "Option is not void", tag: "option", found: true, goName: "Option"},
+		{name: "Heading", tag: "h1", found: true, goName: "H1"},
+		{name: "Raw text", tag: "script", found: true, goName: "Script", text: RawText},
+		{name: "Escapable raw text", tag: "textarea", found: true, goName: "Textarea", text: EscapableRawText},
+		{name: "Obsolete element", tag: "param", found: true, goName: "Param", void: true, obsolete: true},
+		{name: "Unknown element", tag: "blink"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, ok := Lookup(tt.tag)
+			if ok != tt.found {
+				t.Fatalf("expected found %v, got %v", tt.found, ok)
+			}
+			if !ok {
+				return
+			}
+			if e.GoName() != tt.goName {
+				t.Errorf("expected %q, got %q", tt.goName, e.GoName())
+			}
+			if e.Void != tt.void || e.Text != tt.text || e.Obsolete != tt.obsolete {
+				t.Errorf("expected void %v, text %v, obsolete %v, got %v, %v, %v", tt.void, tt.text, tt.obsolete, e.Void, e.Text, e.Obsolete)
+			}
+		})
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		tag      string
+		attr     string
+		found    bool
+		expected ValueType
+	}{
+		{name: "Element attribute", tag: "a", attr: "href", found: true, expected: URL},
+		{name: "Enumerated attribute", tag: "input", attr: "type", found: true, expected: Enum},
+		{name: "Boolean attribute", tag: "input", attr: "disabled", found: true, expected: Boolean},
+		{name: "Global attribute", tag: "div", attr: "tabindex", found: true, expected: Integer},
+		{name: "Not allowed", tag: "div", attr: "href"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, _ := Lookup(tt.tag)
+			a, ok := e.Attribute(tt.attr)
+			if ok != tt.found || a.Type != tt.expected {
+				t.Errorf("expected %v (found %v), got %v (found %v)", tt.expected, tt.found, a.Type, ok)
+			}
+		})
+	}
+
+	if a, ok := LookupARIA("aria-checked"); !ok || a.Type != Tristate {
+		t.Errorf("expected aria-checked to be tristate, got %v", a.Type)
+	}
+	if r, ok := LookupRole("checkbox"); !ok || len(r.Required) != 1 || r.Required[0] != "aria-checked" {
+		t.Errorf("expected checkbox to require aria-checked, got %v", r.Required)
+	}
+	if r, _ := LookupRole("generic"); !r.NamingProhibited {
+		t.Error("expected naming to be prohibited on generic")
+	}
+}
+
+func TestContent(t *testing.T) {
+	p, _ := Lookup("p")
+	ul, _ := Lookup("ul")
+	div, _ := Lookup("div")
+	span, _ := Lookup("span")
+	li, _ := Lookup("li")
+
+	if !p.Content.AllowsText() || ul.Content.AllowsText() {
+		t.Error("expected text in p but not in ul")
+	}
+	if p.Content.Allows("div", div.Categories) {
+		t.Error("expected p not to allow div")
+	}
+	if !p.Content.Allows("span", span.Categories) || !ul.Content.Allows("li", li.Categories) {
+		t.Error("expected p to allow span and ul to allow li")
+	}
+	if got := (Flow | Phrasing).String(); got != "flow|phrasing" {
+		t.Errorf("expected %q, got %q", "flow|phrasing", got)
+	}
+}
+
+func TestIsEventHandler(t *testing.T) {
+	for name, expected := range map[string]bool{"onclick": true, "onkeydown": true, "on": false, "one-way": false, "class": false} {
+		if got := IsEventHandler(name); got != expected {
+			t.Errorf("%s: expected %v, got %v", name, expected, got)
+		}
+	}
+}
+
+// TestConsistency checks that the tables only refer to names they define.
+func TestConsistency(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, e := range Elements {
+		if seen[e.Name] {
+			t.Errorf("duplicate element %q", e.Name)
+		}
+		seen[e.Name] = true
+		if e.Void && e.Content.Kind != Nothing {
+			t.Errorf("void element %q has content", e.Name)
+		}
+		for _, list := range [][]string{e.Parents, e.Required, e.Content.Tags, e.Content.ExcludeTags} {
+			for _, tag := range list {
+				if _, ok := Lookup(tag); !ok {
+					t.Errorf("%q refers to unknown element %q", e.Name, tag)
+				}
+			}
+		}
+	}
+	for _, r := range Roles {
+		for _, a := range r.Required {
+			if _, ok := LookupARIA(a); !ok {
+				t.Errorf("role %q requires unknown attribute %q", r.Name, a)
+			}
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Package sse streams rendered x elements to the browser as Server-Sent
+// Events, for live updates without a JavaScript framework.
+package sse
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+// DefaultHeartbeat is the interval of keep-alive comments used when
+// Server.Heartbeat is zero.
+const DefaultHeartbeat = 15 * time.Second
+
+// Event is a named, rendered update sent to the client.
+type Event struct {
+	Name string // Event name; empty sends a default "message" event
+	ID   string // Optional event id, reported back by the browser on reconnect
+	Elem x.Elem // Element rendered into the data of the event
+}
+
+// Encode renders e and writes it to w as one event. Every line of the
+// rendered HTML becomes its own data field, which the browser joins back
+// with newlines.
+func Encode(w io.Writer, e Event) error {
+	var data bytes.Buffer
+	if err := e.Elem.Render(&data); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if e.ID != "" {
+		buf.WriteString("id: " + singleLine(e.ID) + "\n")
+	}
+	if e.Name != "" {
+		buf.WriteString("event: " + singleLine(e.Name) + "\n")
+	}
+	text := strings.ReplaceAll(data.String(), "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+	for _, line := range strings.Split(text, "\n") {
+		buf.WriteString("data: " + line + "\n")
+	}
+	buf.WriteString("\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// singleLine removes line breaks, which would end a field early.
+func singleLine(s string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(s)
+}
+
+// Writer sends events over an HTTP response, flushing after each one.
+type Writer struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+// NewWriter sets the event stream headers on w, sends them and returns a
+// Writer for the response.
+func NewWriter(w http.ResponseWriter) (*Writer, error) {
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	// Stop proxies such as nginx from buffering the stream.
+	h.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	s := &Writer{w: w, rc: http.NewResponseController(w)}
+	if err := s.rc.Flush(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Send renders elem and sends it as an event with the given name.
+func (s *Writer) Send(name string, elem x.Elem) error {
+	return s.SendEvent(Event{Name: name, Elem: elem})
+}
+
+// SendEvent sends e and flushes the response.
+func (s *Writer) SendEvent(e Event) error {
+	if err := Encode(s.w, e); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+// Heartbeat sends a comment, keeping the connection open through proxies
+// that close idle connections.
+func (s *Writer) Heartbeat() error {
+	if _, err := io.WriteString(s.w, ": heartbeat\n\n"); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+// Retry tells the browser how long to wait before reconnecting.
+func (s *Writer) Retry(d time.Duration) error {
+	if _, err := io.WriteString(s.w, "retry: "+strconv.FormatInt(d.Milliseconds(), 10)+"\n\n"); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+// Server configures how events are streamed.
+type Server struct {
+	Heartbeat time.Duration // Interval of keep-alive comments; zero uses DefaultHeartbeat, negative disables them
+	Retry     time.Duration // Reconnection delay sent to the browser; zero keeps the browser default
+}
+
+// Serve streams events to the client until the channel is closed or the
+// request context is cancelled, sending heartbeats while idle. It returns
+// nil when the channel is closed, the context error when the client goes
+// away, and otherwise the first render or write error.
+func (s Server) Serve(w http.ResponseWriter, r *http.Request, events <-chan Event) error {
+	sw, err := NewWriter(w)
+	if err != nil {
+		return err
+	}
+	if s.Retry > 0 {
+		if err := sw.Retry(s.Retry); err != nil {
+			return err
+		}
+	}
+
+	interval := s.Heartbeat
+	if interval == 0 {
+		interval = DefaultHeartbeat
+	}
+	var heartbeat <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		heartbeat = ticker.C
+	}
+
+	ctx := r.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-heartbeat:
+			if err := sw.Heartbeat(); err != nil {
+				return err
+			}
+		case e, ok := <-events:
+			if !ok {
+				// The channel may have been closed because of the cancellation.
+				return ctx.Err()
+			}
+			if err := sw.SendEvent(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ServeSeq streams the (name, element) pairs produced by seq, such as an
+// iter.Seq2[string, x.Elem], like Serve. The sequence runs in its own
+// goroutine and is stopped when the client goes away.
+func (s Server) ServeSeq(w http.ResponseWriter, r *http.Request, seq func(yield func(string, x.Elem) bool)) error {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		seq(func(name string, elem x.Elem) bool {
+			select {
+			case events <- Event{Name: name, Elem: elem}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+	return s.Serve(w, r.WithContext(ctx), events)
+}
+
+// Serve streams events with the default Server settings.
+func Serve(w http.ResponseWriter, r *http.Request, events <-chan Event) error {
+	return Server{}.Serve(w, r, events)
+}
+
+// ServeSeq streams the pairs produced by seq with the default Server settings.
+func ServeSeq(w http.ResponseWriter, r *http.Request, seq func(yield func(string, x.Elem) bool)) error {
+	return Server{}.ServeSeq(w, r, seq)
+}
+
+// Connect creates the attributes connecting an element to the event stream
+// at url through the htmx SSE extension.
+func Connect(url string) x.Elem {
+	return x.Fragment(x.Att("hx-ext", "sse"), x.Att("sse-connect", url))
+}
+
+// SwapOn creates an sse-swap attribute swapping the data of the named
+// events into the element through the htmx SSE extension.
+func SwapOn(events ...string) x.Elem {
+	return x.Att("sse-swap", strings.Join(events, ","))
+}
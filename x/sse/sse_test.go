@@ -0,0 +1,72 @@
+package sse
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+func TestEncode(t *testing.T) {
+	var buf bytes.Buffer
+	err := Encode(&buf, Event{Name: "update", ID: "7", Elem: x.E("pre", x.C("a\r\nb"))})
+	if err != nil {
+		t.Fatalf("Encode() returned an error: %v", err)
+	}
+	expected := "id: 7\nevent: update\ndata: <pre>a\ndata: b</pre>\n\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestServe(t *testing.T) {
+	events := make(chan Event, 2)
+	events <- Event{Name: "count", Elem: x.Span(x.C("1"))}
+	events <- Event{Name: "count", Elem: x.Span(x.C("2"))}
+	close(events)
+
+	rec := httptest.NewRecorder()
+	err := Serve(rec, httptest.NewRequest(http.MethodGet, "/events", nil), events)
+	if err != nil {
+		t.Fatalf("Serve() returned an error: %v", err)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("expected event stream content type, got %q", ct)
+	}
+	expected := "event: count\ndata: <span>1</span>\n\nevent: count\ndata: <span>2</span>\n\n"
+	if rec.Body.String() != expected {
+		t.Errorf("expected %q, got %q", expected, rec.Body.String())
+	}
+}
+
+func TestServeSeq_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	seq := func(yield func(string, x.Elem) bool) {
+		defer close(stopped)
+		for i := 0; ; i++ {
+			if i == 2 {
+				cancel()
+			}
+			if !yield("tick", x.C(i)) {
+				return
+			}
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/events", nil).WithContext(ctx)
+	err := Server{Heartbeat: time.Millisecond}.ServeSeq(rec, req, seq)
+	if err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("expected the sequence to stop after cancellation")
+	}
+}
@@ -0,0 +1,290 @@
+// Package static exports pages built with x to a directory of static files,
+// together with their assets and a sitemap.
+package static
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+// Exporter renders routes to files. The zero value renders with one worker
+// per CPU, copies no assets and writes a sitemap of root-relative paths.
+type Exporter struct {
+	Assets      fs.FS  // Files copied into the output directory as they are
+	BaseURL     string // Prefix of the locations in sitemap.xml, such as "https://example.com"
+	Concurrency int    // Number of routes rendered at once; zero uses GOMAXPROCS
+}
+
+// Report describes the outcome of an export.
+type Report struct {
+	Written     []string     // Files created or changed, relative to the output directory
+	Unchanged   []string     // Files whose content was already up to date
+	BrokenLinks []BrokenLink // Internal links to pages or assets that do not exist
+}
+
+// BrokenLink is an internal link whose target is not part of the export.
+type BrokenLink struct {
+	Page string // Route containing the link
+	Href string // Value of the href attribute
+}
+
+func (l BrokenLink) String() string {
+	return l.Page + ": " + l.Href
+}
+
+// Export renders routes into dir with the default Exporter.
+func Export(dir string, routes map[string]func() (x.Elem, error)) (*Report, error) {
+	return Exporter{}.Export(dir, routes)
+}
+
+// Export renders every route to its file in dir, "/" to index.html and
+// "/docs/intro" to docs/intro/index.html, while routes with an extension,
+// such as "/404.html", are written to that file. It then copies the assets
+// and writes sitemap.xml listing the page routes that rendered. Files whose content has
+// not changed are left untouched, so their modification times only move
+// when a page does. Links in A elements that point to neither a route nor
+// an asset are listed in the report.
+//
+// Rendering continues past failing routes; the returned error joins the
+// failures, and the report covers everything that was written.
+func (ex Exporter) Export(dir string, routes map[string]func() (x.Elem, error)) (*Report, error) {
+	paths := make([]string, 0, len(routes))
+	for route := range routes {
+		if err := checkRoute(route); err != nil {
+			return nil, err
+		}
+		paths = append(paths, route)
+	}
+	sort.Strings(paths)
+
+	results := make([]pageResult, len(paths))
+	workers := ex.Concurrency
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				results[i] = renderPage(dir, paths[i], routes[paths[i]])
+			}
+		}()
+	}
+	for i := range paths {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+
+	report := &Report{}
+	var errs []error
+	exists := make(map[string]bool, len(paths))
+	rendered := make([]string, 0, len(paths))
+	for i, res := range results {
+		if res.err != nil {
+			errs = append(errs, fmt.Errorf("static: route %s: %w", paths[i], res.err))
+			continue
+		}
+		report.add(res.file, res.changed)
+		exists[strings.TrimSuffix(paths[i], "/")] = true
+		rendered = append(rendered, paths[i])
+	}
+
+	if ex.Assets != nil {
+		err := fs.WalkDir(ex.Assets, ".", func(name string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			data, err := fs.ReadFile(ex.Assets, name)
+			if err != nil {
+				return err
+			}
+			changed, err := writeIfChanged(filepath.Join(dir, filepath.FromSlash(name)), data)
+			if err != nil {
+				return err
+			}
+			report.add(name, changed)
+			exists["/"+name] = true
+			return nil
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("static: copying assets: %w", err))
+		}
+	}
+
+	sitemap, err := ex.sitemap(rendered)
+	if err == nil {
+		var changed bool
+		changed, err = writeIfChanged(filepath.Join(dir, "sitemap.xml"), sitemap)
+		report.add("sitemap.xml", changed)
+	}
+	if err != nil {
+		errs = append(errs, fmt.Errorf("static: writing sitemap: %w", err))
+	}
+
+	for i, res := range results {
+		for _, href := range res.links {
+			if !linkExists(exists, paths[i], href) {
+				report.BrokenLinks = append(report.BrokenLinks, BrokenLink{Page: paths[i], Href: href})
+			}
+		}
+	}
+
+	sort.Strings(report.Written)
+	sort.Strings(report.Unchanged)
+	return report, errors.Join(errs...)
+}
+
+func (r *Report) add(file string, changed bool) {
+	if changed {
+		r.Written = append(r.Written, file)
+	} else {
+		r.Unchanged = append(r.Unchanged, file)
+	}
+}
+
+// checkRoute rejects routes that would be written outside the output directory.
+func checkRoute(route string) error {
+	if !strings.HasPrefix(route, "/") {
+		return fmt.Errorf("static: route %q must start with /", route)
+	}
+	trimmed := strings.TrimSuffix(route, "/")
+	if trimmed != "" && path.Clean(trimmed) != trimmed {
+		return fmt.Errorf("static: route %q is not a clean path", route)
+	}
+	return nil
+}
+
+// outputFile returns the slash-separated file a route is written to.
+func outputFile(route string) string {
+	name := strings.Trim(route, "/")
+	if path.Ext(name) != "" {
+		return name
+	}
+	return path.Join(name, "index.html")
+}
+
+type pageResult struct {
+	file    string
+	changed bool
+	links   []string
+	err     error
+}
+
+func renderPage(dir, route string, fn func() (x.Elem, error)) pageResult {
+	res := pageResult{file: outputFile(route)}
+	page, err := fn()
+	if err != nil {
+		res.err = err
+		return res
+	}
+	var buf bytes.Buffer
+	if err := page.Render(&buf); err != nil {
+		res.err = err
+		return res
+	}
+	links, err := x.Query(page, "a[href]")
+	if err != nil {
+		res.err = err
+		return res
+	}
+	for _, a := range links {
+		href, _ := a.Attr("href")
+		res.links = append(res.links, href)
+	}
+	res.changed, res.err = writeIfChanged(filepath.Join(dir, filepath.FromSlash(res.file)), buf.Bytes())
+	return res
+}
+
+// writeIfChanged writes data to name unless the file already holds it, and
+// reports whether it wrote.
+func writeIfChanged(name string, data []byte) (bool, error) {
+	if old, err := os.ReadFile(name); err == nil && bytes.Equal(old, data) {
+		return false, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return false, err
+	}
+	if err := os.WriteFile(name, data, 0o644); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// linkExists reports whether href, found on the page at route, points to an
+// exported page or asset. Links to other sites are not checked.
+func linkExists(exists map[string]bool, route, href string) bool {
+	u, err := url.Parse(href)
+	if err != nil {
+		return false
+	}
+	if u.Scheme != "" || u.Host != "" || u.Opaque != "" || u.Path == "" {
+		return true
+	}
+
+	target := u.Path
+	if !strings.HasPrefix(target, "/") {
+		// Pages are served as directories, so relative links resolve
+		// against the route itself.
+		base := route
+		if path.Ext(base) != "" {
+			base = path.Dir(base)
+		}
+		target = path.Join(base, target)
+	}
+	target = path.Clean("/" + target)
+	if target == "/" {
+		target = ""
+	}
+	if exists[target] {
+		return true
+	}
+	if strings.HasSuffix(target, "/index.html") {
+		return exists[strings.TrimSuffix(target, "/index.html")]
+	}
+	return false
+}
+
+type urlset struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []urlElement `xml:"url"`
+}
+
+type urlElement struct {
+	Loc string `xml:"loc"`
+}
+
+// sitemap lists the page routes; routes written to a named file, such as
+// an error page, are left out.
+func (ex Exporter) sitemap(paths []string) ([]byte, error) {
+	set := urlset{Xmlns: "http://www.sitemaps.org/schemas/sitemap/0.9"}
+	base := strings.TrimSuffix(ex.BaseURL, "/")
+	for _, route := range paths {
+		if path.Ext(route) != "" {
+			continue
+		}
+		set.URLs = append(set.URLs, urlElement{Loc: base + route})
+	}
+	out, err := xml.MarshalIndent(set, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(out, '\n')...), nil
+}
@@ -0,0 +1,128 @@
+package static
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+func testRoutes(title string) map[string]func() (x.Elem, error) {
+	return map[string]func() (x.Elem, error){
+		"/": func() (x.Elem, error) {
+			return x.Body(
+				x.H1(x.C(title)),
+				x.A(x.Att("href", "/docs/intro"), x.C("Intro")),
+				x.A(x.Att("href", "docs/missing#top"), x.C("Missing")),
+				x.A(x.Att("href", "/css/site.css"), x.C("Styles")),
+				x.A(x.Att("href", "https://example.org/"), x.C("Elsewhere")),
+			), nil
+		},
+		"/docs/intro": func() (x.Elem, error) {
+			return x.Body(
+				x.A(x.Att("href", "../../"), x.C("Home")),
+				x.A(x.Att("href", "/docs/intro/index.html?ref=self"), x.C("Self")),
+				x.A(x.Att("href", "/img/logo.png"), x.C("Logo")),
+			), nil
+		},
+		"/404.html": func() (x.Elem, error) {
+			return x.Body(x.C("Not found")), nil
+		},
+	}
+}
+
+func TestExport(t *testing.T) {
+	dir := t.TempDir()
+	ex := Exporter{
+		Assets:  fstest.MapFS{"css/site.css": {Data: []byte("body{}")}},
+		BaseURL: "https://example.com/",
+	}
+
+	report, err := ex.Export(dir, testRoutes("Home"))
+	if err != nil {
+		t.Fatalf("Export() returned an error: %v", err)
+	}
+
+	written := []string{"404.html", "css/site.css", "docs/intro/index.html", "index.html", "sitemap.xml"}
+	if !reflect.DeepEqual(report.Written, written) {
+		t.Errorf("expected written %v, got %v", written, report.Written)
+	}
+	broken := []BrokenLink{
+		{Page: "/", Href: "docs/missing#top"},
+		{Page: "/docs/intro", Href: "/img/logo.png"},
+	}
+	if !reflect.DeepEqual(report.BrokenLinks, broken) {
+		t.Errorf("expected broken links %v, got %v", broken, report.BrokenLinks)
+	}
+
+	index, err := os.ReadFile(filepath.Join(dir, "index.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(index), "<body><h1>Home</h1>") {
+		t.Errorf("unexpected index.html: %q", index)
+	}
+
+	sitemap, err := os.ReadFile(filepath.Join(dir, "sitemap.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, loc := range []string{"<loc>https://example.com/</loc>", "<loc>https://example.com/docs/intro</loc>"} {
+		if !strings.Contains(string(sitemap), loc) {
+			t.Errorf("expected sitemap to contain %q, got %q", loc, sitemap)
+		}
+	}
+	if strings.Contains(string(sitemap), "404") {
+		t.Errorf("expected sitemap to leave out 404.html, got %q", sitemap)
+	}
+
+	// A second export only rewrites the page that changed.
+	report, err = ex.Export(dir, testRoutes("Welcome"))
+	if err != nil {
+		t.Fatalf("Export() returned an error: %v", err)
+	}
+	if !reflect.DeepEqual(report.Written, []string{"index.html"}) {
+		t.Errorf("expected only index.html to be written, got %v", report.Written)
+	}
+	if len(report.Unchanged) != 4 {
+		t.Errorf("expected 4 unchanged files, got %v", report.Unchanged)
+	}
+}
+
+func TestExportErrors(t *testing.T) {
+	failure := errors.New("no data")
+	routes := map[string]func() (x.Elem, error){
+		"/ok":     func() (x.Elem, error) { return x.P(x.C("ok")), nil },
+		"/broken": func() (x.Elem, error) { return x.Elem{}, failure },
+	}
+
+	dir := t.TempDir()
+	report, err := Export(dir, routes)
+	if !errors.Is(err, failure) {
+		t.Errorf("expected route error, got %v", err)
+	}
+	if _, statErr := os.Stat(filepath.Join(dir, "ok", "index.html")); statErr != nil {
+		t.Errorf("expected the working route to be written: %v", statErr)
+	}
+	if report == nil || len(report.Written) != 2 {
+		t.Errorf("expected ok/index.html and sitemap.xml to be written, got %+v", report)
+	}
+	sitemap, readErr := os.ReadFile(filepath.Join(dir, "sitemap.xml"))
+	if readErr != nil {
+		t.Fatalf("reading sitemap.xml: %v", readErr)
+	}
+	if !strings.Contains(string(sitemap), "/ok") || strings.Contains(string(sitemap), "/broken") {
+		t.Errorf("expected sitemap to list only /ok, got %q", sitemap)
+	}
+
+	for _, route := range []string{"docs", "/../etc", "/a//b"} {
+		if _, err := Export(dir, map[string]func() (x.Elem, error){route: routes["/ok"]}); err == nil {
+			t.Errorf("expected route %q to be rejected", route)
+		}
+	}
+}
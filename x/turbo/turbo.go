@@ -0,0 +1,136 @@
+// Package turbo builds Hotwire Turbo Stream and Turbo Frame elements and
+// serves streams over HTTP responses and Server-Sent Events.
+package turbo
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/zulubit/xxhtml/x"
+	"github.com/zulubit/xxhtml/x/sse"
+)
+
+// ContentType is the media type of Turbo Stream responses.
+const ContentType = "text/vnd.turbo-stream.html"
+
+// Action is the action of a <turbo-stream> element.
+type Action string
+
+// Stream actions supported by Turbo.
+const (
+	ActionAppend  Action = "append"
+	ActionPrepend Action = "prepend"
+	ActionReplace Action = "replace"
+	ActionUpdate  Action = "update"
+	ActionRemove  Action = "remove"
+	ActionBefore  Action = "before"
+	ActionAfter   Action = "after"
+	ActionRefresh Action = "refresh"
+)
+
+// Stream creates a <turbo-stream> element applying action to the element
+// with the target id. Content is wrapped in a <template>; the remove and
+// refresh actions take no content.
+func Stream(action Action, target string, content ...x.Elem) x.Elem {
+	return stream(action, x.Att("target", target), content)
+}
+
+// StreamAll creates a <turbo-stream> element applying action to every
+// element matching the CSS selector targets.
+func StreamAll(action Action, targets string, content ...x.Elem) x.Elem {
+	return stream(action, x.Att("targets", targets), content)
+}
+
+func stream(action Action, target x.Elem, content []x.Elem) x.Elem {
+	children := []x.Elem{x.Att("action", string(action)), target}
+	if action != ActionRemove && action != ActionRefresh {
+		children = append(children, x.E("template", content...))
+	}
+	return x.E("turbo-stream", children...)
+}
+
+// Append creates a stream appending content to the target's children.
+func Append(target string, content ...x.Elem) x.Elem {
+	return Stream(ActionAppend, target, content...)
+}
+
+// Prepend creates a stream prepending content to the target's children.
+func Prepend(target string, content ...x.Elem) x.Elem {
+	return Stream(ActionPrepend, target, content...)
+}
+
+// Replace creates a stream replacing the target element with content.
+func Replace(target string, content ...x.Elem) x.Elem {
+	return Stream(ActionReplace, target, content...)
+}
+
+// Update creates a stream replacing the target's children with content.
+func Update(target string, content ...x.Elem) x.Elem {
+	return Stream(ActionUpdate, target, content...)
+}
+
+// Remove creates a stream removing the target element.
+func Remove(target string) x.Elem {
+	return Stream(ActionRemove, target)
+}
+
+// Before creates a stream inserting content before the target element.
+func Before(target string, content ...x.Elem) x.Elem {
+	return Stream(ActionBefore, target, content...)
+}
+
+// After creates a stream inserting content after the target element.
+func After(target string, content ...x.Elem) x.Elem {
+	return Stream(ActionAfter, target, content...)
+}
+
+// Frame creates a <turbo-frame> element with the given id.
+func Frame(id string, children ...x.Elem) x.Elem {
+	return x.E("turbo-frame", append([]x.Elem{x.Att("id", id)}, children...)...)
+}
+
+// LazyFrame creates a <turbo-frame> element that loads src once it becomes
+// visible, showing placeholder until then.
+func LazyFrame(id, src string, placeholder ...x.Elem) x.Elem {
+	return Frame(id, x.Att("src", src), x.Att("loading", "lazy"), x.Fragment(placeholder...))
+}
+
+// StreamSource creates a <turbo-stream-source> element subscribing the page
+// to streams sent from src, such as a Server-Sent Events endpoint.
+func StreamSource(src string) x.Elem {
+	return x.E("turbo-stream-source", x.Att("src", src))
+}
+
+// IsStreamRequest reports whether r accepts Turbo Stream responses, as form
+// submissions made by Turbo do.
+func IsStreamRequest(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), ContentType)
+}
+
+// Write renders streams into a buffer and writes them as a Turbo Stream
+// response. Nothing is written, headers included, if rendering fails.
+func Write(w http.ResponseWriter, streams ...x.Elem) error {
+	var buf bytes.Buffer
+	if err := x.Fragment(streams...).Render(&buf); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", ContentType+"; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// WriteEvent renders streams and sends them as one Server-Sent Event, to be
+// received by a <turbo-stream-source>. The response must already be set up
+// as an event stream, for example with sse.NewWriter, and is flushed after
+// the event. Streams can also be sent with sse.Serve as the Elem of an
+// unnamed sse.Event.
+func WriteEvent(w http.ResponseWriter, streams ...x.Elem) error {
+	if err := sse.Encode(w, sse.Event{Elem: x.Fragment(streams...)}); err != nil {
+		return err
+	}
+	return http.NewResponseController(w).Flush()
+}
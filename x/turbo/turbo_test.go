@@ -0,0 +1,100 @@
+package turbo
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+func TestStreams(t *testing.T) {
+	tests := []struct {
+		name     string
+		elem     x.Elem
+		expected string
+	}{
+		{
+			name:     "Append",
+			elem:     Append("messages", x.Div(x.C("Hi"))),
+			expected: `<turbo-stream action="append" target="messages"><template><div>Hi</div></template></turbo-stream>`,
+		},
+		{
+			name:     "Remove",
+			elem:     Remove("message_1"),
+			expected: `<turbo-stream action="remove" target="message_1"></turbo-stream>`,
+		},
+		{
+			name:     "Update all",
+			elem:     StreamAll(ActionUpdate, ".count", x.C("3")),
+			expected: `<turbo-stream action="update" targets=".count"><template>3</template></turbo-stream>`,
+		},
+		{
+			name:     "Lazy frame",
+			elem:     LazyFrame("comments", "/comments", x.P(x.C("Loading"))),
+			expected: `<turbo-frame id="comments" src="/comments" loading="lazy"><p>Loading</p></turbo-frame>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.elem.Render(&buf); err != nil {
+				t.Fatalf("Render() returned an error: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	rec := httptest.NewRecorder()
+	if err := Write(rec, Remove("a"), Remove("b")); err != nil {
+		t.Fatalf("Write() returned an error: %v", err)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "text/vnd.turbo-stream.html; charset=utf-8" {
+		t.Errorf("expected Turbo Stream content type, got %q", ct)
+	}
+	expected := `<turbo-stream action="remove" target="a"></turbo-stream><turbo-stream action="remove" target="b"></turbo-stream>`
+	if rec.Body.String() != expected {
+		t.Errorf("expected %q, got %q", expected, rec.Body.String())
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r.Header.Set("Accept", "text/vnd.turbo-stream.html, text/html")
+	if !IsStreamRequest(r) {
+		t.Error("expected a Turbo Stream request")
+	}
+}
+
+func TestWrite_RenderError(t *testing.T) {
+	rec := httptest.NewRecorder()
+	failing := x.RenderFunc(func(*x.RenderContext) error { return errors.New("boom") })
+	if err := Write(rec, Remove("a"), Update("b", failing)); err == nil {
+		t.Fatal("expected an error")
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "" {
+		t.Errorf("expected no content type, got %q", ct)
+	}
+	if rec.Body.Len() != 0 {
+		t.Errorf("expected an empty body, got %q", rec.Body.String())
+	}
+}
+
+func TestWriteEvent(t *testing.T) {
+	rec := httptest.NewRecorder()
+	if err := WriteEvent(rec, Update("count", x.C("1\n2"))); err != nil {
+		t.Fatalf("WriteEvent() returned an error: %v", err)
+	}
+	expected := "data: <turbo-stream action=\"update\" target=\"count\"><template>1\ndata: 2</template></turbo-stream>\n\n"
+	if rec.Body.String() != expected {
+		t.Errorf("expected %q, got %q", expected, rec.Body.String())
+	}
+	if !rec.Flushed {
+		t.Error("expected the event to be flushed")
+	}
+}
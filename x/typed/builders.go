@@ -0,0 +1,3537 @@
+// Code generated by internal/genhtml from package spec. DO NOT EDIT.
+
+package typed
+
+import "github.com/zulubit/xxhtml/x"
+
+// AcceptCharset is a keyword of the accept-charset attribute. Other values can be given as AcceptCharset(value).
+type AcceptCharset string
+
+const (
+	AcceptCharsetUTF8 AcceptCharset = "utf-8"
+)
+
+// As is a keyword of the as attribute.
+type As string
+
+const (
+	AsFetch    As = "fetch"
+	AsAudio    As = "audio"
+	AsDocument As = "document"
+	AsEmbed    As = "embed"
+	AsFont     As = "font"
+	AsImage    As = "image"
+	AsJSON     As = "json"
+	AsObject   As = "object"
+	AsScript   As = "script"
+	AsStyle    As = "style"
+	AsTrack    As = "track"
+	AsVideo    As = "video"
+	AsWorker   As = "worker"
+)
+
+// AutoCapitalize is a keyword of the autocapitalize attribute.
+type AutoCapitalize string
+
+const (
+	AutoCapitalizeOff        AutoCapitalize = "off"
+	AutoCapitalizeNone       AutoCapitalize = "none"
+	AutoCapitalizeOn         AutoCapitalize = "on"
+	AutoCapitalizeSentences  AutoCapitalize = "sentences"
+	AutoCapitalizeWords      AutoCapitalize = "words"
+	AutoCapitalizeCharacters AutoCapitalize = "characters"
+)
+
+// AutoComplete is a keyword of the autocomplete attribute.
+type AutoComplete string
+
+const (
+	AutoCompleteOn  AutoComplete = "on"
+	AutoCompleteOff AutoComplete = "off"
+)
+
+// AutoCorrect is a keyword of the autocorrect attribute.
+type AutoCorrect string
+
+const (
+	AutoCorrectOn  AutoCorrect = "on"
+	AutoCorrectOff AutoCorrect = "off"
+)
+
+// Blocking is a keyword of the blocking attribute.
+type Blocking string
+
+const (
+	BlockingRender Blocking = "render"
+)
+
+// ButtonType is a keyword of the type attribute of <button>.
+type ButtonType string
+
+const (
+	ButtonSubmit ButtonType = "submit"
+	ButtonReset  ButtonType = "reset"
+	ButtonButton ButtonType = "button"
+)
+
+// Charset is a keyword of the charset attribute.
+type Charset string
+
+const (
+	CharsetUTF8 Charset = "utf-8"
+)
+
+// ClosedBy is a keyword of the closedby attribute.
+type ClosedBy string
+
+const (
+	ClosedByAny          ClosedBy = "any"
+	ClosedByCloserequest ClosedBy = "closerequest"
+	ClosedByNone         ClosedBy = "none"
+)
+
+// ColorSpace is a keyword of the colorspace attribute.
+type ColorSpace string
+
+const (
+	ColorSpaceLimitedSrgb ColorSpace = "limited-srgb"
+	ColorSpaceDisplayP3   ColorSpace = "display-p3"
+)
+
+// Command is a keyword of the command attribute. Other values can be given as Command(value).
+type Command string
+
+const (
+	CommandTogglePopover Command = "toggle-popover"
+	CommandShowPopover   Command = "show-popover"
+	CommandHidePopover   Command = "hide-popover"
+	CommandClose         Command = "close"
+	CommandRequestClose  Command = "request-close"
+	CommandShowModal     Command = "show-modal"
+)
+
+// ContentEditable is a keyword of the contenteditable attribute. Other values can be given as ContentEditable(value).
+type ContentEditable string
+
+const (
+	ContentEditableTrue          ContentEditable = "true"
+	ContentEditableFalse         ContentEditable = "false"
+	ContentEditablePlaintextOnly ContentEditable = "plaintext-only"
+)
+
+// CrossOrigin is a keyword of the crossorigin attribute.
+type CrossOrigin string
+
+const (
+	CrossOriginAnonymous      CrossOrigin = "anonymous"
+	CrossOriginUseCredentials CrossOrigin = "use-credentials"
+)
+
+// Decoding is a keyword of the decoding attribute.
+type Decoding string
+
+const (
+	DecodingSync  Decoding = "sync"
+	DecodingAsync Decoding = "async"
+	DecodingAuto  Decoding = "auto"
+)
+
+// Dir is a keyword of the dir attribute.
+type Dir string
+
+const (
+	DirLTR  Dir = "ltr"
+	DirRTL  Dir = "rtl"
+	DirAuto Dir = "auto"
+)
+
+// Draggable is a keyword of the draggable attribute.
+type Draggable string
+
+const (
+	DraggableTrue  Draggable = "true"
+	DraggableFalse Draggable = "false"
+)
+
+// EncType is a keyword of the enctype and formenctype attributes.
+type EncType string
+
+const (
+	EncTypeApplicationXWwwFormUrlencoded EncType = "application/x-www-form-urlencoded"
+	EncTypeMultipartFormData             EncType = "multipart/form-data"
+	EncTypeTextPlain                     EncType = "text/plain"
+)
+
+// EnterKeyHint is a keyword of the enterkeyhint attribute.
+type EnterKeyHint string
+
+const (
+	EnterKeyHintEnter    EnterKeyHint = "enter"
+	EnterKeyHintDone     EnterKeyHint = "done"
+	EnterKeyHintGo       EnterKeyHint = "go"
+	EnterKeyHintNext     EnterKeyHint = "next"
+	EnterKeyHintPrevious EnterKeyHint = "previous"
+	EnterKeyHintSearch   EnterKeyHint = "search"
+	EnterKeyHintSend     EnterKeyHint = "send"
+)
+
+// FetchPriority is a keyword of the fetchpriority attribute.
+type FetchPriority string
+
+const (
+	FetchPriorityHigh FetchPriority = "high"
+	FetchPriorityLow  FetchPriority = "low"
+	FetchPriorityAuto FetchPriority = "auto"
+)
+
+// HTTPEquiv is a keyword of the http-equiv attribute.
+type HTTPEquiv string
+
+const (
+	HTTPEquivContentLanguage       HTTPEquiv = "content-language"
+	HTTPEquivContentType           HTTPEquiv = "content-type"
+	HTTPEquivDefaultStyle          HTTPEquiv = "default-style"
+	HTTPEquivRefresh               HTTPEquiv = "refresh"
+	HTTPEquivSetCookie             HTTPEquiv = "set-cookie"
+	HTTPEquivXUACompatible         HTTPEquiv = "x-ua-compatible"
+	HTTPEquivContentSecurityPolicy HTTPEquiv = "content-security-policy"
+)
+
+// InputMode is a keyword of the inputmode attribute.
+type InputMode string
+
+const (
+	InputModeNone    InputMode = "none"
+	InputModeText    InputMode = "text"
+	InputModeDecimal InputMode = "decimal"
+	InputModeNumeric InputMode = "numeric"
+	InputModeTel     InputMode = "tel"
+	InputModeSearch  InputMode = "search"
+	InputModeEmail   InputMode = "email"
+	InputModeURL     InputMode = "url"
+)
+
+// InputType is a keyword of the type attribute of <input>.
+type InputType string
+
+const (
+	InputButton        InputType = "button"
+	InputCheckbox      InputType = "checkbox"
+	InputColor         InputType = "color"
+	InputDate          InputType = "date"
+	InputDatetimeLocal InputType = "datetime-local"
+	InputEmail         InputType = "email"
+	InputFile          InputType = "file"
+	InputHidden        InputType = "hidden"
+	InputImage         InputType = "image"
+	InputMonth         InputType = "month"
+	InputNumber        InputType = "number"
+	InputPassword      InputType = "password"
+	InputRadio         InputType = "radio"
+	InputRange         InputType = "range"
+	InputReset         InputType = "reset"
+	InputSearch        InputType = "search"
+	InputSubmit        InputType = "submit"
+	InputTel           InputType = "tel"
+	InputText          InputType = "text"
+	InputTime          InputType = "time"
+	InputURL           InputType = "url"
+	InputWeek          InputType = "week"
+)
+
+// Kind is a keyword of the kind attribute.
+type Kind string
+
+const (
+	KindSubtitles    Kind = "subtitles"
+	KindCaptions     Kind = "captions"
+	KindDescriptions Kind = "descriptions"
+	KindChapters     Kind = "chapters"
+	KindMetadata     Kind = "metadata"
+)
+
+// LinkSizes is a keyword of the sizes attribute of <link>.
+type LinkSizes string
+
+const (
+	LinkSizesAny LinkSizes = "any"
+)
+
+// Loading is a keyword of the loading attribute.
+type Loading string
+
+const (
+	LoadingLazy  Loading = "lazy"
+	LoadingEager Loading = "eager"
+)
+
+// MetaName is a keyword of the name attribute of <meta>. Other values can be given as MetaName(value).
+type MetaName string
+
+const (
+	MetaNameApplicationName MetaName = "application-name"
+	MetaNameAuthor          MetaName = "author"
+	MetaNameColorScheme     MetaName = "color-scheme"
+	MetaNameDescription     MetaName = "description"
+	MetaNameGenerator       MetaName = "generator"
+	MetaNameKeywords        MetaName = "keywords"
+	MetaNameReferrer        MetaName = "referrer"
+	MetaNameThemeColor      MetaName = "theme-color"
+	MetaNameViewport        MetaName = "viewport"
+)
+
+// Method is a keyword of the method and formmethod attributes.
+type Method string
+
+const (
+	MethodGet    Method = "get"
+	MethodPost   Method = "post"
+	MethodDialog Method = "dialog"
+)
+
+// Popover is a keyword of the popover attribute. Other values can be given as Popover(value).
+type Popover string
+
+const (
+	PopoverAuto   Popover = "auto"
+	PopoverManual Popover = "manual"
+	PopoverHint   Popover = "hint"
+)
+
+// PopoverTargetAction is a keyword of the popovertargetaction attribute.
+type PopoverTargetAction string
+
+const (
+	PopoverTargetActionToggle PopoverTargetAction = "toggle"
+	PopoverTargetActionShow   PopoverTargetAction = "show"
+	PopoverTargetActionHide   PopoverTargetAction = "hide"
+)
+
+// Preload is a keyword of the preload attribute.
+type Preload string
+
+const (
+	PreloadNone     Preload = "none"
+	PreloadMetadata Preload = "metadata"
+	PreloadAuto     Preload = "auto"
+)
+
+// ReferrerPolicy is a keyword of the referrerpolicy attribute.
+type ReferrerPolicy string
+
+const (
+	ReferrerPolicyNoReferrer                  ReferrerPolicy = "no-referrer"
+	ReferrerPolicyNoReferrerWhenDowngrade     ReferrerPolicy = "no-referrer-when-downgrade"
+	ReferrerPolicySameOrigin                  ReferrerPolicy = "same-origin"
+	ReferrerPolicyOrigin                      ReferrerPolicy = "origin"
+	ReferrerPolicyStrictOrigin                ReferrerPolicy = "strict-origin"
+	ReferrerPolicyOriginWhenCrossOrigin       ReferrerPolicy = "origin-when-cross-origin"
+	ReferrerPolicyStrictOriginWhenCrossOrigin ReferrerPolicy = "strict-origin-when-cross-origin"
+	ReferrerPolicyUnsafeURL                   ReferrerPolicy = "unsafe-url"
+)
+
+// Rel is a keyword of the rel attribute.
+type Rel string
+
+const (
+	RelAlternate      Rel = "alternate"
+	RelAuthor         Rel = "author"
+	RelBookmark       Rel = "bookmark"
+	RelCanonical      Rel = "canonical"
+	RelDNSPrefetch    Rel = "dns-prefetch"
+	RelExpect         Rel = "expect"
+	RelExternal       Rel = "external"
+	RelHelp           Rel = "help"
+	RelIcon           Rel = "icon"
+	RelLicense        Rel = "license"
+	RelManifest       Rel = "manifest"
+	RelMe             Rel = "me"
+	RelModulepreload  Rel = "modulepreload"
+	RelNext           Rel = "next"
+	RelNofollow       Rel = "nofollow"
+	RelNoopener       Rel = "noopener"
+	RelNoreferrer     Rel = "noreferrer"
+	RelOpener         Rel = "opener"
+	RelPingback       Rel = "pingback"
+	RelPreconnect     Rel = "preconnect"
+	RelPrefetch       Rel = "prefetch"
+	RelPreload        Rel = "preload"
+	RelPrev           Rel = "prev"
+	RelPrivacyPolicy  Rel = "privacy-policy"
+	RelSearch         Rel = "search"
+	RelStylesheet     Rel = "stylesheet"
+	RelTag            Rel = "tag"
+	RelTermsOfService Rel = "terms-of-service"
+)
+
+// Sandbox is a keyword of the sandbox attribute.
+type Sandbox string
+
+const (
+	SandboxAllowDownloads                      Sandbox = "allow-downloads"
+	SandboxAllowForms                          Sandbox = "allow-forms"
+	SandboxAllowModals                         Sandbox = "allow-modals"
+	SandboxAllowOrientationLock                Sandbox = "allow-orientation-lock"
+	SandboxAllowPointerLock                    Sandbox = "allow-pointer-lock"
+	SandboxAllowPopups                         Sandbox = "allow-popups"
+	SandboxAllowPopupsToEscapeSandbox          Sandbox = "allow-popups-to-escape-sandbox"
+	SandboxAllowPresentation                   Sandbox = "allow-presentation"
+	SandboxAllowSameOrigin                     Sandbox = "allow-same-origin"
+	SandboxAllowScripts                        Sandbox = "allow-scripts"
+	SandboxAllowTopNavigation                  Sandbox = "allow-top-navigation"
+	SandboxAllowTopNavigationByUserActivation  Sandbox = "allow-top-navigation-by-user-activation"
+	SandboxAllowTopNavigationToCustomProtocols Sandbox = "allow-top-navigation-to-custom-protocols"
+)
+
+// Scope is a keyword of the scope attribute.
+type Scope string
+
+const (
+	ScopeRow      Scope = "row"
+	ScopeCol      Scope = "col"
+	ScopeRowgroup Scope = "rowgroup"
+	ScopeColgroup Scope = "colgroup"
+)
+
+// ScriptType is a keyword of the type attribute of <script>. Other values can be given as ScriptType(value).
+type ScriptType string
+
+const (
+	ScriptModule           ScriptType = "module"
+	ScriptImportmap        ScriptType = "importmap"
+	ScriptSpeculationrules ScriptType = "speculationrules"
+)
+
+// ShadowRootMode is a keyword of the shadowrootmode attribute.
+type ShadowRootMode string
+
+const (
+	ShadowRootModeOpen   ShadowRootMode = "open"
+	ShadowRootModeClosed ShadowRootMode = "closed"
+)
+
+// Shape is a keyword of the shape attribute.
+type Shape string
+
+const (
+	ShapeCircle  Shape = "circle"
+	ShapeDefault Shape = "default"
+	ShapePoly    Shape = "poly"
+	ShapeRect    Shape = "rect"
+)
+
+// SpellCheck is a keyword of the spellcheck attribute. Other values can be given as SpellCheck(value).
+type SpellCheck string
+
+const (
+	SpellCheckTrue  SpellCheck = "true"
+	SpellCheckFalse SpellCheck = "false"
+)
+
+// Step is a keyword of the step attribute. Other values can be given as Step(value).
+type Step string
+
+const (
+	StepAny Step = "any"
+)
+
+// Target is a keyword of the target and formtarget attributes. Other values can be given as Target(value).
+type Target string
+
+const (
+	Blank  Target = "_blank"
+	Self   Target = "_self"
+	Parent Target = "_parent"
+	Top    Target = "_top"
+)
+
+// Translate is a keyword of the translate attribute.
+type Translate string
+
+const (
+	TranslateYes Translate = "yes"
+	TranslateNo  Translate = "no"
+)
+
+// Wrap is a keyword of the wrap attribute.
+type Wrap string
+
+const (
+	WrapSoft Wrap = "soft"
+	WrapHard Wrap = "hard"
+)
+
+// WritingSuggestions is a keyword of the writingsuggestions attribute.
+type WritingSuggestions string
+
+const (
+	WritingSuggestionsTrue  WritingSuggestions = "true"
+	WritingSuggestionsFalse WritingSuggestions = "false"
+)
+
+// AccessKey sets the accesskey attribute to values separated by spaces.
+func (b base[B]) AccessKey(values ...string) B {
+	return b.add(x.Att("accesskey", joinTokens(values)))
+}
+
+// AutoCapitalize sets the autocapitalize attribute.
+func (b base[B]) AutoCapitalize(value AutoCapitalize) B {
+	return b.add(x.Att("autocapitalize", string(value)))
+}
+
+// AutoCorrect sets the autocorrect attribute.
+func (b base[B]) AutoCorrect(value AutoCorrect) B {
+	return b.add(x.Att("autocorrect", string(value)))
+}
+
+// AutoFocus sets the boolean autofocus attribute.
+func (b base[B]) AutoFocus() B {
+	return b.add(x.AttBool("autofocus", true))
+}
+
+// Class sets the class attribute to values separated by spaces.
+func (b base[B]) Class(values ...string) B {
+	return b.add(x.Att("class", joinTokens(values)))
+}
+
+// ContentEditable sets the contenteditable attribute.
+func (b base[B]) ContentEditable(value ContentEditable) B {
+	return b.add(x.Att("contenteditable", string(value)))
+}
+
+// Dir sets the dir attribute.
+func (b base[B]) Dir(value Dir) B {
+	return b.add(x.Att("dir", string(value)))
+}
+
+// Draggable sets the draggable attribute.
+func (b base[B]) Draggable(value Draggable) B {
+	return b.add(x.Att("draggable", string(value)))
+}
+
+// EnterKeyHint sets the enterkeyhint attribute.
+func (b base[B]) EnterKeyHint(value EnterKeyHint) B {
+	return b.add(x.Att("enterkeyhint", string(value)))
+}
+
+// Hidden sets the boolean hidden attribute.
+func (b base[B]) Hidden() B {
+	return b.add(x.AttBool("hidden", true))
+}
+
+// ID sets the id attribute.
+func (b base[B]) ID(value string) B {
+	return b.add(x.Att("id", value))
+}
+
+// Inert sets the boolean inert attribute.
+func (b base[B]) Inert() B {
+	return b.add(x.AttBool("inert", true))
+}
+
+// InputMode sets the inputmode attribute.
+func (b base[B]) InputMode(value InputMode) B {
+	return b.add(x.Att("inputmode", string(value)))
+}
+
+// Is sets the is attribute.
+func (b base[B]) Is(value string) B {
+	return b.add(x.Att("is", value))
+}
+
+// ItemID sets the itemid attribute.
+func (b base[B]) ItemID(value string) B {
+	return b.add(x.Att("itemid", value))
+}
+
+// ItemProp sets the itemprop attribute to values separated by spaces.
+func (b base[B]) ItemProp(values ...string) B {
+	return b.add(x.Att("itemprop", joinTokens(values)))
+}
+
+// ItemRef sets the itemref attribute.
+func (b base[B]) ItemRef(value string) B {
+	return b.add(x.Att("itemref", value))
+}
+
+// ItemScope sets the boolean itemscope attribute.
+func (b base[B]) ItemScope() B {
+	return b.add(x.AttBool("itemscope", true))
+}
+
+// ItemType sets the itemtype attribute.
+func (b base[B]) ItemType(value string) B {
+	return b.add(x.Att("itemtype", value))
+}
+
+// Lang sets the lang attribute.
+func (b base[B]) Lang(value string) B {
+	return b.add(x.Att("lang", value))
+}
+
+// Nonce sets the nonce attribute.
+func (b base[B]) Nonce(value string) B {
+	return b.add(x.Att("nonce", value))
+}
+
+// Popover sets the popover attribute.
+func (b base[B]) Popover(value Popover) B {
+	return b.add(x.Att("popover", string(value)))
+}
+
+// Role sets the role attribute to values separated by spaces.
+func (b base[B]) Role(values ...string) B {
+	return b.add(x.Att("role", joinTokens(values)))
+}
+
+// Slot sets the slot attribute.
+func (b base[B]) Slot(value string) B {
+	return b.add(x.Att("slot", value))
+}
+
+// SpellCheck sets the spellcheck attribute.
+func (b base[B]) SpellCheck(value SpellCheck) B {
+	return b.add(x.Att("spellcheck", string(value)))
+}
+
+// Style sets the style attribute.
+func (b base[B]) Style(value string) B {
+	return b.add(x.Att("style", value))
+}
+
+// TabIndex sets the tabindex attribute to an integer.
+func (b base[B]) TabIndex(n int) B {
+	return b.add(x.AttInt("tabindex", n))
+}
+
+// Title sets the title attribute.
+func (b base[B]) Title(value string) B {
+	return b.add(x.Att("title", value))
+}
+
+// Translate sets the translate attribute.
+func (b base[B]) Translate(value Translate) B {
+	return b.add(x.Att("translate", string(value)))
+}
+
+// WritingSuggestions sets the writingsuggestions attribute.
+func (b base[B]) WritingSuggestions(value WritingSuggestions) B {
+	return b.add(x.Att("writingsuggestions", string(value)))
+}
+
+// HtmlBuilder builds a <html> element.
+type HtmlBuilder struct{ base[HtmlBuilder] }
+
+// Html starts a <html> (root) element with optional children.
+func Html(children ...x.Elem) HtmlBuilder {
+	return HtmlBuilder{newBase(x.Html(children...), func(b base[HtmlBuilder]) HtmlBuilder {
+		return HtmlBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b HtmlBuilder) Children(children ...x.Elem) HtmlBuilder {
+	return b.add(children...)
+}
+
+// XMLNS sets the xmlns attribute.
+func (b HtmlBuilder) XMLNS(value string) HtmlBuilder {
+	return b.add(x.Att("xmlns", value))
+}
+
+// HeadBuilder builds a <head> element.
+type HeadBuilder struct{ base[HeadBuilder] }
+
+// Head starts a <head> (document metadata) element with optional children.
+func Head(children ...x.Elem) HeadBuilder {
+	return HeadBuilder{newBase(x.Head(children...), func(b base[HeadBuilder]) HeadBuilder {
+		return HeadBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b HeadBuilder) Children(children ...x.Elem) HeadBuilder {
+	return b.add(children...)
+}
+
+// TitleBuilder builds a <title> element.
+type TitleBuilder struct{ base[TitleBuilder] }
+
+// Title starts a <title> (document title) element with optional children.
+func Title(children ...x.Elem) TitleBuilder {
+	return TitleBuilder{newBase(x.Title(children...), func(b base[TitleBuilder]) TitleBuilder {
+		return TitleBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b TitleBuilder) Children(children ...x.Elem) TitleBuilder {
+	return b.add(children...)
+}
+
+// BaseBuilder builds a <base> element.
+type BaseBuilder struct{ base[BaseBuilder] }
+
+// Base starts a <base> (document base URL) element. This is a self-closing tag, so it takes no children.
+func Base() BaseBuilder {
+	return BaseBuilder{newBase(x.Base(), func(b base[BaseBuilder]) BaseBuilder {
+		return BaseBuilder{b}
+	})}
+}
+
+// Href sets the href attribute.
+func (b BaseBuilder) Href(value string) BaseBuilder {
+	return b.add(x.Att("href", value))
+}
+
+// Target sets the target attribute.
+func (b BaseBuilder) Target(value Target) BaseBuilder {
+	return b.add(x.Att("target", string(value)))
+}
+
+// LinkBuilder builds a <link> element.
+type LinkBuilder struct{ base[LinkBuilder] }
+
+// Link starts a <link> (external resource link) element. This is a self-closing tag, so it takes no children.
+func Link() LinkBuilder {
+	return LinkBuilder{newBase(x.Link(), func(b base[LinkBuilder]) LinkBuilder {
+		return LinkBuilder{b}
+	})}
+}
+
+// Href sets the href attribute.
+func (b LinkBuilder) Href(value string) LinkBuilder {
+	return b.add(x.Att("href", value))
+}
+
+// CrossOrigin sets the crossorigin attribute.
+func (b LinkBuilder) CrossOrigin(value CrossOrigin) LinkBuilder {
+	return b.add(x.Att("crossorigin", string(value)))
+}
+
+// Rel sets the rel attribute to values separated by spaces.
+func (b LinkBuilder) Rel(values ...Rel) LinkBuilder {
+	return b.add(x.Att("rel", joinTokens(values)))
+}
+
+// As sets the as attribute.
+func (b LinkBuilder) As(value As) LinkBuilder {
+	return b.add(x.Att("as", string(value)))
+}
+
+// Media sets the media attribute.
+func (b LinkBuilder) Media(value string) LinkBuilder {
+	return b.add(x.Att("media", value))
+}
+
+// Integrity sets the integrity attribute.
+func (b LinkBuilder) Integrity(value string) LinkBuilder {
+	return b.add(x.Att("integrity", value))
+}
+
+// HrefLang sets the hreflang attribute.
+func (b LinkBuilder) HrefLang(value string) LinkBuilder {
+	return b.add(x.Att("hreflang", value))
+}
+
+// Type sets the type attribute.
+func (b LinkBuilder) Type(value string) LinkBuilder {
+	return b.add(x.Att("type", value))
+}
+
+// ReferrerPolicy sets the referrerpolicy attribute.
+func (b LinkBuilder) ReferrerPolicy(value ReferrerPolicy) LinkBuilder {
+	return b.add(x.Att("referrerpolicy", string(value)))
+}
+
+// Sizes sets the sizes attribute to values separated by spaces.
+func (b LinkBuilder) Sizes(values ...LinkSizes) LinkBuilder {
+	return b.add(x.Att("sizes", joinTokens(values)))
+}
+
+// ImageSrcSet sets the imagesrcset attribute.
+func (b LinkBuilder) ImageSrcSet(value string) LinkBuilder {
+	return b.add(x.Att("imagesrcset", value))
+}
+
+// ImageSizes sets the imagesizes attribute.
+func (b LinkBuilder) ImageSizes(value string) LinkBuilder {
+	return b.add(x.Att("imagesizes", value))
+}
+
+// Blocking sets the blocking attribute to values separated by spaces.
+func (b LinkBuilder) Blocking(values ...Blocking) LinkBuilder {
+	return b.add(x.Att("blocking", joinTokens(values)))
+}
+
+// Color sets the color attribute.
+func (b LinkBuilder) Color(value string) LinkBuilder {
+	return b.add(x.Att("color", value))
+}
+
+// Disabled sets the boolean disabled attribute.
+func (b LinkBuilder) Disabled() LinkBuilder {
+	return b.add(x.AttBool("disabled", true))
+}
+
+// FetchPriority sets the fetchpriority attribute.
+func (b LinkBuilder) FetchPriority(value FetchPriority) LinkBuilder {
+	return b.add(x.Att("fetchpriority", string(value)))
+}
+
+// MetaBuilder builds a <meta> element.
+type MetaBuilder struct{ base[MetaBuilder] }
+
+// Meta starts a <meta> (metadata) element. This is a self-closing tag, so it takes no children.
+func Meta() MetaBuilder {
+	return MetaBuilder{newBase(x.Meta(), func(b base[MetaBuilder]) MetaBuilder {
+		return MetaBuilder{b}
+	})}
+}
+
+// Name sets the name attribute.
+func (b MetaBuilder) Name(value MetaName) MetaBuilder {
+	return b.add(x.Att("name", string(value)))
+}
+
+// HTTPEquiv sets the http-equiv attribute.
+func (b MetaBuilder) HTTPEquiv(value HTTPEquiv) MetaBuilder {
+	return b.add(x.Att("http-equiv", string(value)))
+}
+
+// Content sets the content attribute.
+func (b MetaBuilder) Content(value string) MetaBuilder {
+	return b.add(x.Att("content", value))
+}
+
+// Charset sets the charset attribute.
+func (b MetaBuilder) Charset(value Charset) MetaBuilder {
+	return b.add(x.Att("charset", string(value)))
+}
+
+// Media sets the media attribute.
+func (b MetaBuilder) Media(value string) MetaBuilder {
+	return b.add(x.Att("media", value))
+}
+
+// StyleBuilder builds a <style> element.
+type StyleBuilder struct{ base[StyleBuilder] }
+
+// Style starts a <style> (style information) element with optional children.
+func Style(children ...x.Elem) StyleBuilder {
+	return StyleBuilder{newBase(x.Style(children...), func(b base[StyleBuilder]) StyleBuilder {
+		return StyleBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b StyleBuilder) Children(children ...x.Elem) StyleBuilder {
+	return b.add(children...)
+}
+
+// Media sets the media attribute.
+func (b StyleBuilder) Media(value string) StyleBuilder {
+	return b.add(x.Att("media", value))
+}
+
+// Blocking sets the blocking attribute to values separated by spaces.
+func (b StyleBuilder) Blocking(values ...Blocking) StyleBuilder {
+	return b.add(x.Att("blocking", joinTokens(values)))
+}
+
+// BodyBuilder builds a <body> element.
+type BodyBuilder struct{ base[BodyBuilder] }
+
+// Body starts a <body> (document body) element with optional children.
+func Body(children ...x.Elem) BodyBuilder {
+	return BodyBuilder{newBase(x.Body(children...), func(b base[BodyBuilder]) BodyBuilder {
+		return BodyBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b BodyBuilder) Children(children ...x.Elem) BodyBuilder {
+	return b.add(children...)
+}
+
+// ArticleBuilder builds an <article> element.
+type ArticleBuilder struct{ base[ArticleBuilder] }
+
+// Article starts an <article> element with optional children.
+func Article(children ...x.Elem) ArticleBuilder {
+	return ArticleBuilder{newBase(x.Article(children...), func(b base[ArticleBuilder]) ArticleBuilder {
+		return ArticleBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b ArticleBuilder) Children(children ...x.Elem) ArticleBuilder {
+	return b.add(children...)
+}
+
+// SectionBuilder builds a <section> element.
+type SectionBuilder struct{ base[SectionBuilder] }
+
+// Section starts a <section> element with optional children.
+func Section(children ...x.Elem) SectionBuilder {
+	return SectionBuilder{newBase(x.Section(children...), func(b base[SectionBuilder]) SectionBuilder {
+		return SectionBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b SectionBuilder) Children(children ...x.Elem) SectionBuilder {
+	return b.add(children...)
+}
+
+// NavBuilder builds a <nav> element.
+type NavBuilder struct{ base[NavBuilder] }
+
+// Nav starts a <nav> (navigation section) element with optional children.
+func Nav(children ...x.Elem) NavBuilder {
+	return NavBuilder{newBase(x.Nav(children...), func(b base[NavBuilder]) NavBuilder {
+		return NavBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b NavBuilder) Children(children ...x.Elem) NavBuilder {
+	return b.add(children...)
+}
+
+// AsideBuilder builds an <aside> element.
+type AsideBuilder struct{ base[AsideBuilder] }
+
+// Aside starts an <aside> element with optional children.
+func Aside(children ...x.Elem) AsideBuilder {
+	return AsideBuilder{newBase(x.Aside(children...), func(b base[AsideBuilder]) AsideBuilder {
+		return AsideBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b AsideBuilder) Children(children ...x.Elem) AsideBuilder {
+	return b.add(children...)
+}
+
+// H1Builder builds a <h1> element.
+type H1Builder struct{ base[H1Builder] }
+
+// H1 starts a <h1> (level 1 heading) element with optional children.
+func H1(children ...x.Elem) H1Builder {
+	return H1Builder{newBase(x.H1(children...), func(b base[H1Builder]) H1Builder {
+		return H1Builder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b H1Builder) Children(children ...x.Elem) H1Builder {
+	return b.add(children...)
+}
+
+// H2Builder builds a <h2> element.
+type H2Builder struct{ base[H2Builder] }
+
+// H2 starts a <h2> (level 2 heading) element with optional children.
+func H2(children ...x.Elem) H2Builder {
+	return H2Builder{newBase(x.H2(children...), func(b base[H2Builder]) H2Builder {
+		return H2Builder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b H2Builder) Children(children ...x.Elem) H2Builder {
+	return b.add(children...)
+}
+
+// H3Builder builds a <h3> element.
+type H3Builder struct{ base[H3Builder] }
+
+// H3 starts a <h3> (level 3 heading) element with optional children.
+func H3(children ...x.Elem) H3Builder {
+	return H3Builder{newBase(x.H3(children...), func(b base[H3Builder]) H3Builder {
+		return H3Builder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b H3Builder) Children(children ...x.Elem) H3Builder {
+	return b.add(children...)
+}
+
+// H4Builder builds a <h4> element.
+type H4Builder struct{ base[H4Builder] }
+
+// H4 starts a <h4> (level 4 heading) element with optional children.
+func H4(children ...x.Elem) H4Builder {
+	return H4Builder{newBase(x.H4(children...), func(b base[H4Builder]) H4Builder {
+		return H4Builder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b H4Builder) Children(children ...x.Elem) H4Builder {
+	return b.add(children...)
+}
+
+// H5Builder builds a <h5> element.
+type H5Builder struct{ base[H5Builder] }
+
+// H5 starts a <h5> (level 5 heading) element with optional children.
+func H5(children ...x.Elem) H5Builder {
+	return H5Builder{newBase(x.H5(children...), func(b base[H5Builder]) H5Builder {
+		return H5Builder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b H5Builder) Children(children ...x.Elem) H5Builder {
+	return b.add(children...)
+}
+
+// H6Builder builds a <h6> element.
+type H6Builder struct{ base[H6Builder] }
+
+// H6 starts a <h6> (level 6 heading) element with optional children.
+func H6(children ...x.Elem) H6Builder {
+	return H6Builder{newBase(x.H6(children...), func(b base[H6Builder]) H6Builder {
+		return H6Builder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b H6Builder) Children(children ...x.Elem) H6Builder {
+	return b.add(children...)
+}
+
+// HgroupBuilder builds a <hgroup> element.
+type HgroupBuilder struct{ base[HgroupBuilder] }
+
+// Hgroup starts a <hgroup> (heading group) element with optional children.
+func Hgroup(children ...x.Elem) HgroupBuilder {
+	return HgroupBuilder{newBase(x.Hgroup(children...), func(b base[HgroupBuilder]) HgroupBuilder {
+		return HgroupBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b HgroupBuilder) Children(children ...x.Elem) HgroupBuilder {
+	return b.add(children...)
+}
+
+// HeaderBuilder builds a <header> element.
+type HeaderBuilder struct{ base[HeaderBuilder] }
+
+// Header starts a <header> element with optional children.
+func Header(children ...x.Elem) HeaderBuilder {
+	return HeaderBuilder{newBase(x.Header(children...), func(b base[HeaderBuilder]) HeaderBuilder {
+		return HeaderBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b HeaderBuilder) Children(children ...x.Elem) HeaderBuilder {
+	return b.add(children...)
+}
+
+// FooterBuilder builds a <footer> element.
+type FooterBuilder struct{ base[FooterBuilder] }
+
+// Footer starts a <footer> element with optional children.
+func Footer(children ...x.Elem) FooterBuilder {
+	return FooterBuilder{newBase(x.Footer(children...), func(b base[FooterBuilder]) FooterBuilder {
+		return FooterBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b FooterBuilder) Children(children ...x.Elem) FooterBuilder {
+	return b.add(children...)
+}
+
+// AddressBuilder builds an <address> element.
+type AddressBuilder struct{ base[AddressBuilder] }
+
+// Address starts an <address> (contact information) element with optional children.
+func Address(children ...x.Elem) AddressBuilder {
+	return AddressBuilder{newBase(x.Address(children...), func(b base[AddressBuilder]) AddressBuilder {
+		return AddressBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b AddressBuilder) Children(children ...x.Elem) AddressBuilder {
+	return b.add(children...)
+}
+
+// PBuilder builds a <p> element.
+type PBuilder struct{ base[PBuilder] }
+
+// P starts a <p> (paragraph) element with optional children.
+func P(children ...x.Elem) PBuilder {
+	return PBuilder{newBase(x.P(children...), func(b base[PBuilder]) PBuilder {
+		return PBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b PBuilder) Children(children ...x.Elem) PBuilder {
+	return b.add(children...)
+}
+
+// HrBuilder builds a <hr> element.
+type HrBuilder struct{ base[HrBuilder] }
+
+// Hr starts a <hr> (thematic break) element. This is a self-closing tag, so it takes no children.
+func Hr() HrBuilder {
+	return HrBuilder{newBase(x.Hr(), func(b base[HrBuilder]) HrBuilder {
+		return HrBuilder{b}
+	})}
+}
+
+// PreBuilder builds a <pre> element.
+type PreBuilder struct{ base[PreBuilder] }
+
+// Pre starts a <pre> (preformatted text) element with optional children.
+func Pre(children ...x.Elem) PreBuilder {
+	return PreBuilder{newBase(x.Pre(children...), func(b base[PreBuilder]) PreBuilder {
+		return PreBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b PreBuilder) Children(children ...x.Elem) PreBuilder {
+	return b.add(children...)
+}
+
+// BlockquoteBuilder builds a <blockquote> element.
+type BlockquoteBuilder struct{ base[BlockquoteBuilder] }
+
+// Blockquote starts a <blockquote> (block quotation) element with optional children.
+func Blockquote(children ...x.Elem) BlockquoteBuilder {
+	return BlockquoteBuilder{newBase(x.Blockquote(children...), func(b base[BlockquoteBuilder]) BlockquoteBuilder {
+		return BlockquoteBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b BlockquoteBuilder) Children(children ...x.Elem) BlockquoteBuilder {
+	return b.add(children...)
+}
+
+// Cite sets the cite attribute.
+func (b BlockquoteBuilder) Cite(value string) BlockquoteBuilder {
+	return b.add(x.Att("cite", value))
+}
+
+// OlBuilder builds an <ol> element.
+type OlBuilder struct{ base[OlBuilder] }
+
+// Ol starts an <ol> (ordered list) element with optional children.
+func Ol(children ...x.Elem) OlBuilder {
+	return OlBuilder{newBase(x.Ol(children...), func(b base[OlBuilder]) OlBuilder {
+		return OlBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b OlBuilder) Children(children ...x.Elem) OlBuilder {
+	return b.add(children...)
+}
+
+// Reversed sets the boolean reversed attribute.
+func (b OlBuilder) Reversed() OlBuilder {
+	return b.add(x.AttBool("reversed", true))
+}
+
+// Start sets the start attribute to an integer.
+func (b OlBuilder) Start(n int) OlBuilder {
+	return b.add(x.AttInt("start", n))
+}
+
+// Type sets the type attribute.
+func (b OlBuilder) Type(value string) OlBuilder {
+	return b.add(x.Att("type", value))
+}
+
+// UlBuilder builds a <ul> element.
+type UlBuilder struct{ base[UlBuilder] }
+
+// Ul starts a <ul> (unordered list) element with optional children.
+func Ul(children ...x.Elem) UlBuilder {
+	return UlBuilder{newBase(x.Ul(children...), func(b base[UlBuilder]) UlBuilder {
+		return UlBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b UlBuilder) Children(children ...x.Elem) UlBuilder {
+	return b.add(children...)
+}
+
+// MenuBuilder builds a <menu> element.
+type MenuBuilder struct{ base[MenuBuilder] }
+
+// Menu starts a <menu> (toolbar menu) element with optional children.
+func Menu(children ...x.Elem) MenuBuilder {
+	return MenuBuilder{newBase(x.Menu(children...), func(b base[MenuBuilder]) MenuBuilder {
+		return MenuBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b MenuBuilder) Children(children ...x.Elem) MenuBuilder {
+	return b.add(children...)
+}
+
+// LiBuilder builds a <li> element.
+type LiBuilder struct{ base[LiBuilder] }
+
+// Li starts a <li> (list item) element with optional children.
+func Li(children ...x.Elem) LiBuilder {
+	return LiBuilder{newBase(x.Li(children...), func(b base[LiBuilder]) LiBuilder {
+		return LiBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b LiBuilder) Children(children ...x.Elem) LiBuilder {
+	return b.add(children...)
+}
+
+// Value sets the value attribute to an integer.
+func (b LiBuilder) Value(n int) LiBuilder {
+	return b.add(x.AttInt("value", n))
+}
+
+// DlBuilder builds a <dl> element.
+type DlBuilder struct{ base[DlBuilder] }
+
+// Dl starts a <dl> (description list) element with optional children.
+func Dl(children ...x.Elem) DlBuilder {
+	return DlBuilder{newBase(x.Dl(children...), func(b base[DlBuilder]) DlBuilder {
+		return DlBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b DlBuilder) Children(children ...x.Elem) DlBuilder {
+	return b.add(children...)
+}
+
+// DtBuilder builds a <dt> element.
+type DtBuilder struct{ base[DtBuilder] }
+
+// Dt starts a <dt> (description term) element with optional children.
+func Dt(children ...x.Elem) DtBuilder {
+	return DtBuilder{newBase(x.Dt(children...), func(b base[DtBuilder]) DtBuilder {
+		return DtBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b DtBuilder) Children(children ...x.Elem) DtBuilder {
+	return b.add(children...)
+}
+
+// DdBuilder builds a <dd> element.
+type DdBuilder struct{ base[DdBuilder] }
+
+// Dd starts a <dd> (description details) element with optional children.
+func Dd(children ...x.Elem) DdBuilder {
+	return DdBuilder{newBase(x.Dd(children...), func(b base[DdBuilder]) DdBuilder {
+		return DdBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b DdBuilder) Children(children ...x.Elem) DdBuilder {
+	return b.add(children...)
+}
+
+// FigureBuilder builds a <figure> element.
+type FigureBuilder struct{ base[FigureBuilder] }
+
+// Figure starts a <figure> element with optional children.
+func Figure(children ...x.Elem) FigureBuilder {
+	return FigureBuilder{newBase(x.Figure(children...), func(b base[FigureBuilder]) FigureBuilder {
+		return FigureBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b FigureBuilder) Children(children ...x.Elem) FigureBuilder {
+	return b.add(children...)
+}
+
+// FigcaptionBuilder builds a <figcaption> element.
+type FigcaptionBuilder struct{ base[FigcaptionBuilder] }
+
+// Figcaption starts a <figcaption> (figure caption) element with optional children.
+func Figcaption(children ...x.Elem) FigcaptionBuilder {
+	return FigcaptionBuilder{newBase(x.Figcaption(children...), func(b base[FigcaptionBuilder]) FigcaptionBuilder {
+		return FigcaptionBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b FigcaptionBuilder) Children(children ...x.Elem) FigcaptionBuilder {
+	return b.add(children...)
+}
+
+// MainBuilder builds a <main> element.
+type MainBuilder struct{ base[MainBuilder] }
+
+// Main starts a <main> (main content) element with optional children.
+func Main(children ...x.Elem) MainBuilder {
+	return MainBuilder{newBase(x.Main(children...), func(b base[MainBuilder]) MainBuilder {
+		return MainBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b MainBuilder) Children(children ...x.Elem) MainBuilder {
+	return b.add(children...)
+}
+
+// SearchBuilder builds a <search> element.
+type SearchBuilder struct{ base[SearchBuilder] }
+
+// Search starts a <search> (search section) element with optional children.
+func Search(children ...x.Elem) SearchBuilder {
+	return SearchBuilder{newBase(x.Search(children...), func(b base[SearchBuilder]) SearchBuilder {
+		return SearchBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b SearchBuilder) Children(children ...x.Elem) SearchBuilder {
+	return b.add(children...)
+}
+
+// DivBuilder builds a <div> element.
+type DivBuilder struct{ base[DivBuilder] }
+
+// Div starts a <div> (content division) element with optional children.
+func Div(children ...x.Elem) DivBuilder {
+	return DivBuilder{newBase(x.Div(children...), func(b base[DivBuilder]) DivBuilder {
+		return DivBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b DivBuilder) Children(children ...x.Elem) DivBuilder {
+	return b.add(children...)
+}
+
+// ABuilder builds an <a> element.
+type ABuilder struct{ base[ABuilder] }
+
+// A starts an <a> (anchor) element with optional children.
+func A(children ...x.Elem) ABuilder {
+	return ABuilder{newBase(x.A(children...), func(b base[ABuilder]) ABuilder {
+		return ABuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b ABuilder) Children(children ...x.Elem) ABuilder {
+	return b.add(children...)
+}
+
+// Href sets the href attribute.
+func (b ABuilder) Href(value string) ABuilder {
+	return b.add(x.Att("href", value))
+}
+
+// Target sets the target attribute.
+func (b ABuilder) Target(value Target) ABuilder {
+	return b.add(x.Att("target", string(value)))
+}
+
+// Download sets the download attribute.
+func (b ABuilder) Download(value string) ABuilder {
+	return b.add(x.Att("download", value))
+}
+
+// Ping sets the ping attribute.
+func (b ABuilder) Ping(value string) ABuilder {
+	return b.add(x.Att("ping", value))
+}
+
+// Rel sets the rel attribute to values separated by spaces.
+func (b ABuilder) Rel(values ...Rel) ABuilder {
+	return b.add(x.Att("rel", joinTokens(values)))
+}
+
+// HrefLang sets the hreflang attribute.
+func (b ABuilder) HrefLang(value string) ABuilder {
+	return b.add(x.Att("hreflang", value))
+}
+
+// Type sets the type attribute.
+func (b ABuilder) Type(value string) ABuilder {
+	return b.add(x.Att("type", value))
+}
+
+// ReferrerPolicy sets the referrerpolicy attribute.
+func (b ABuilder) ReferrerPolicy(value ReferrerPolicy) ABuilder {
+	return b.add(x.Att("referrerpolicy", string(value)))
+}
+
+// EmBuilder builds an <em> element.
+type EmBuilder struct{ base[EmBuilder] }
+
+// Em starts an <em> (emphasis) element with optional children.
+func Em(children ...x.Elem) EmBuilder {
+	return EmBuilder{newBase(x.Em(children...), func(b base[EmBuilder]) EmBuilder {
+		return EmBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b EmBuilder) Children(children ...x.Elem) EmBuilder {
+	return b.add(children...)
+}
+
+// StrongBuilder builds a <strong> element.
+type StrongBuilder struct{ base[StrongBuilder] }
+
+// Strong starts a <strong> (strong importance) element with optional children.
+func Strong(children ...x.Elem) StrongBuilder {
+	return StrongBuilder{newBase(x.Strong(children...), func(b base[StrongBuilder]) StrongBuilder {
+		return StrongBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b StrongBuilder) Children(children ...x.Elem) StrongBuilder {
+	return b.add(children...)
+}
+
+// SmallBuilder builds a <small> element.
+type SmallBuilder struct{ base[SmallBuilder] }
+
+// Small starts a <small> (side comment) element with optional children.
+func Small(children ...x.Elem) SmallBuilder {
+	return SmallBuilder{newBase(x.Small(children...), func(b base[SmallBuilder]) SmallBuilder {
+		return SmallBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b SmallBuilder) Children(children ...x.Elem) SmallBuilder {
+	return b.add(children...)
+}
+
+// SBuilder builds a <s> element.
+type SBuilder struct{ base[SBuilder] }
+
+// S starts a <s> (strikethrough) element with optional children.
+func S(children ...x.Elem) SBuilder {
+	return SBuilder{newBase(x.S(children...), func(b base[SBuilder]) SBuilder {
+		return SBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b SBuilder) Children(children ...x.Elem) SBuilder {
+	return b.add(children...)
+}
+
+// CiteBuilder builds a <cite> element.
+type CiteBuilder struct{ base[CiteBuilder] }
+
+// Cite starts a <cite> (citation) element with optional children.
+func Cite(children ...x.Elem) CiteBuilder {
+	return CiteBuilder{newBase(x.Cite(children...), func(b base[CiteBuilder]) CiteBuilder {
+		return CiteBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b CiteBuilder) Children(children ...x.Elem) CiteBuilder {
+	return b.add(children...)
+}
+
+// QBuilder builds a <q> element.
+type QBuilder struct{ base[QBuilder] }
+
+// Q starts a <q> (inline quotation) element with optional children.
+func Q(children ...x.Elem) QBuilder {
+	return QBuilder{newBase(x.Q(children...), func(b base[QBuilder]) QBuilder {
+		return QBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b QBuilder) Children(children ...x.Elem) QBuilder {
+	return b.add(children...)
+}
+
+// Cite sets the cite attribute.
+func (b QBuilder) Cite(value string) QBuilder {
+	return b.add(x.Att("cite", value))
+}
+
+// DfnBuilder builds a <dfn> element.
+type DfnBuilder struct{ base[DfnBuilder] }
+
+// Dfn starts a <dfn> (definition) element with optional children.
+func Dfn(children ...x.Elem) DfnBuilder {
+	return DfnBuilder{newBase(x.Dfn(children...), func(b base[DfnBuilder]) DfnBuilder {
+		return DfnBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b DfnBuilder) Children(children ...x.Elem) DfnBuilder {
+	return b.add(children...)
+}
+
+// AbbrBuilder builds an <abbr> element.
+type AbbrBuilder struct{ base[AbbrBuilder] }
+
+// Abbr starts an <abbr> (abbreviation) element with optional children.
+func Abbr(children ...x.Elem) AbbrBuilder {
+	return AbbrBuilder{newBase(x.Abbr(children...), func(b base[AbbrBuilder]) AbbrBuilder {
+		return AbbrBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b AbbrBuilder) Children(children ...x.Elem) AbbrBuilder {
+	return b.add(children...)
+}
+
+// RubyBuilder builds a <ruby> element.
+type RubyBuilder struct{ base[RubyBuilder] }
+
+// Ruby starts a <ruby> (ruby annotation) element with optional children.
+func Ruby(children ...x.Elem) RubyBuilder {
+	return RubyBuilder{newBase(x.Ruby(children...), func(b base[RubyBuilder]) RubyBuilder {
+		return RubyBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b RubyBuilder) Children(children ...x.Elem) RubyBuilder {
+	return b.add(children...)
+}
+
+// RtBuilder builds a <rt> element.
+type RtBuilder struct{ base[RtBuilder] }
+
+// Rt starts a <rt> (ruby text) element with optional children.
+func Rt(children ...x.Elem) RtBuilder {
+	return RtBuilder{newBase(x.Rt(children...), func(b base[RtBuilder]) RtBuilder {
+		return RtBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b RtBuilder) Children(children ...x.Elem) RtBuilder {
+	return b.add(children...)
+}
+
+// RpBuilder builds a <rp> element.
+type RpBuilder struct{ base[RpBuilder] }
+
+// Rp starts a <rp> (ruby parenthesis) element with optional children.
+func Rp(children ...x.Elem) RpBuilder {
+	return RpBuilder{newBase(x.Rp(children...), func(b base[RpBuilder]) RpBuilder {
+		return RpBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b RpBuilder) Children(children ...x.Elem) RpBuilder {
+	return b.add(children...)
+}
+
+// DataBuilder builds a <data> element.
+type DataBuilder struct{ base[DataBuilder] }
+
+// Data starts a <data> (machine-readable data) element with optional children.
+func Data(children ...x.Elem) DataBuilder {
+	return DataBuilder{newBase(x.DataElem(children...), func(b base[DataBuilder]) DataBuilder {
+		return DataBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b DataBuilder) Children(children ...x.Elem) DataBuilder {
+	return b.add(children...)
+}
+
+// Value sets the value attribute.
+func (b DataBuilder) Value(value string) DataBuilder {
+	return b.add(x.Att("value", value))
+}
+
+// TimeBuilder builds a <time> element.
+type TimeBuilder struct{ base[TimeBuilder] }
+
+// Time starts a <time> element with optional children.
+func Time(children ...x.Elem) TimeBuilder {
+	return TimeBuilder{newBase(x.Time(children...), func(b base[TimeBuilder]) TimeBuilder {
+		return TimeBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b TimeBuilder) Children(children ...x.Elem) TimeBuilder {
+	return b.add(children...)
+}
+
+// DateTime sets the datetime attribute.
+func (b TimeBuilder) DateTime(value string) TimeBuilder {
+	return b.add(x.Att("datetime", value))
+}
+
+// CodeBuilder builds a <code> element.
+type CodeBuilder struct{ base[CodeBuilder] }
+
+// Code starts a <code> element with optional children.
+func Code(children ...x.Elem) CodeBuilder {
+	return CodeBuilder{newBase(x.Code(children...), func(b base[CodeBuilder]) CodeBuilder {
+		return CodeBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b CodeBuilder) Children(children ...x.Elem) CodeBuilder {
+	return b.add(children...)
+}
+
+// VarBuilder builds a <var> element.
+type VarBuilder struct{ base[VarBuilder] }
+
+// Var starts a <var> (variable) element with optional children.
+func Var(children ...x.Elem) VarBuilder {
+	return VarBuilder{newBase(x.Var(children...), func(b base[VarBuilder]) VarBuilder {
+		return VarBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b VarBuilder) Children(children ...x.Elem) VarBuilder {
+	return b.add(children...)
+}
+
+// SampBuilder builds a <samp> element.
+type SampBuilder struct{ base[SampBuilder] }
+
+// Samp starts a <samp> (sample output) element with optional children.
+func Samp(children ...x.Elem) SampBuilder {
+	return SampBuilder{newBase(x.Samp(children...), func(b base[SampBuilder]) SampBuilder {
+		return SampBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b SampBuilder) Children(children ...x.Elem) SampBuilder {
+	return b.add(children...)
+}
+
+// KbdBuilder builds a <kbd> element.
+type KbdBuilder struct{ base[KbdBuilder] }
+
+// Kbd starts a <kbd> (keyboard input) element with optional children.
+func Kbd(children ...x.Elem) KbdBuilder {
+	return KbdBuilder{newBase(x.Kbd(children...), func(b base[KbdBuilder]) KbdBuilder {
+		return KbdBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b KbdBuilder) Children(children ...x.Elem) KbdBuilder {
+	return b.add(children...)
+}
+
+// SubBuilder builds a <sub> element.
+type SubBuilder struct{ base[SubBuilder] }
+
+// Sub starts a <sub> (subscript) element with optional children.
+func Sub(children ...x.Elem) SubBuilder {
+	return SubBuilder{newBase(x.Sub(children...), func(b base[SubBuilder]) SubBuilder {
+		return SubBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b SubBuilder) Children(children ...x.Elem) SubBuilder {
+	return b.add(children...)
+}
+
+// SupBuilder builds a <sup> element.
+type SupBuilder struct{ base[SupBuilder] }
+
+// Sup starts a <sup> (superscript) element with optional children.
+func Sup(children ...x.Elem) SupBuilder {
+	return SupBuilder{newBase(x.Sup(children...), func(b base[SupBuilder]) SupBuilder {
+		return SupBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b SupBuilder) Children(children ...x.Elem) SupBuilder {
+	return b.add(children...)
+}
+
+// IBuilder builds an <i> element.
+type IBuilder struct{ base[IBuilder] }
+
+// I starts an <i> (idiomatic text) element with optional children.
+func I(children ...x.Elem) IBuilder {
+	return IBuilder{newBase(x.I(children...), func(b base[IBuilder]) IBuilder {
+		return IBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b IBuilder) Children(children ...x.Elem) IBuilder {
+	return b.add(children...)
+}
+
+// BBuilder builds a <b> element.
+type BBuilder struct{ base[BBuilder] }
+
+// B starts a <b> (bring attention) element with optional children.
+func B(children ...x.Elem) BBuilder {
+	return BBuilder{newBase(x.B(children...), func(b base[BBuilder]) BBuilder {
+		return BBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b BBuilder) Children(children ...x.Elem) BBuilder {
+	return b.add(children...)
+}
+
+// UBuilder builds a <u> element.
+type UBuilder struct{ base[UBuilder] }
+
+// U starts a <u> (unarticulated annotation) element with optional children.
+func U(children ...x.Elem) UBuilder {
+	return UBuilder{newBase(x.U(children...), func(b base[UBuilder]) UBuilder {
+		return UBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b UBuilder) Children(children ...x.Elem) UBuilder {
+	return b.add(children...)
+}
+
+// MarkBuilder builds a <mark> element.
+type MarkBuilder struct{ base[MarkBuilder] }
+
+// Mark starts a <mark> (highlight) element with optional children.
+func Mark(children ...x.Elem) MarkBuilder {
+	return MarkBuilder{newBase(x.Mark(children...), func(b base[MarkBuilder]) MarkBuilder {
+		return MarkBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b MarkBuilder) Children(children ...x.Elem) MarkBuilder {
+	return b.add(children...)
+}
+
+// BdiBuilder builds a <bdi> element.
+type BdiBuilder struct{ base[BdiBuilder] }
+
+// Bdi starts a <bdi> (bidirectional isolate) element with optional children.
+func Bdi(children ...x.Elem) BdiBuilder {
+	return BdiBuilder{newBase(x.Bdi(children...), func(b base[BdiBuilder]) BdiBuilder {
+		return BdiBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b BdiBuilder) Children(children ...x.Elem) BdiBuilder {
+	return b.add(children...)
+}
+
+// BdoBuilder builds a <bdo> element.
+type BdoBuilder struct{ base[BdoBuilder] }
+
+// Bdo starts a <bdo> (bidirectional override) element with optional children.
+func Bdo(children ...x.Elem) BdoBuilder {
+	return BdoBuilder{newBase(x.Bdo(children...), func(b base[BdoBuilder]) BdoBuilder {
+		return BdoBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b BdoBuilder) Children(children ...x.Elem) BdoBuilder {
+	return b.add(children...)
+}
+
+// SpanBuilder builds a <span> element.
+type SpanBuilder struct{ base[SpanBuilder] }
+
+// Span starts a <span> element with optional children.
+func Span(children ...x.Elem) SpanBuilder {
+	return SpanBuilder{newBase(x.Span(children...), func(b base[SpanBuilder]) SpanBuilder {
+		return SpanBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b SpanBuilder) Children(children ...x.Elem) SpanBuilder {
+	return b.add(children...)
+}
+
+// BrBuilder builds a <br> element.
+type BrBuilder struct{ base[BrBuilder] }
+
+// Br starts a <br> (line break) element. This is a self-closing tag, so it takes no children.
+func Br() BrBuilder {
+	return BrBuilder{newBase(x.Br(), func(b base[BrBuilder]) BrBuilder {
+		return BrBuilder{b}
+	})}
+}
+
+// WbrBuilder builds a <wbr> element.
+type WbrBuilder struct{ base[WbrBuilder] }
+
+// Wbr starts a <wbr> (line break opportunity) element. This is a self-closing tag, so it takes no children.
+func Wbr() WbrBuilder {
+	return WbrBuilder{newBase(x.Wbr(), func(b base[WbrBuilder]) WbrBuilder {
+		return WbrBuilder{b}
+	})}
+}
+
+// InsBuilder builds an <ins> element.
+type InsBuilder struct{ base[InsBuilder] }
+
+// Ins starts an <ins> (inserted text) element with optional children.
+func Ins(children ...x.Elem) InsBuilder {
+	return InsBuilder{newBase(x.Ins(children...), func(b base[InsBuilder]) InsBuilder {
+		return InsBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b InsBuilder) Children(children ...x.Elem) InsBuilder {
+	return b.add(children...)
+}
+
+// Cite sets the cite attribute.
+func (b InsBuilder) Cite(value string) InsBuilder {
+	return b.add(x.Att("cite", value))
+}
+
+// DateTime sets the datetime attribute.
+func (b InsBuilder) DateTime(value string) InsBuilder {
+	return b.add(x.Att("datetime", value))
+}
+
+// DelBuilder builds a <del> element.
+type DelBuilder struct{ base[DelBuilder] }
+
+// Del starts a <del> (deleted text) element with optional children.
+func Del(children ...x.Elem) DelBuilder {
+	return DelBuilder{newBase(x.Del(children...), func(b base[DelBuilder]) DelBuilder {
+		return DelBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b DelBuilder) Children(children ...x.Elem) DelBuilder {
+	return b.add(children...)
+}
+
+// Cite sets the cite attribute.
+func (b DelBuilder) Cite(value string) DelBuilder {
+	return b.add(x.Att("cite", value))
+}
+
+// DateTime sets the datetime attribute.
+func (b DelBuilder) DateTime(value string) DelBuilder {
+	return b.add(x.Att("datetime", value))
+}
+
+// PictureBuilder builds a <picture> element.
+type PictureBuilder struct{ base[PictureBuilder] }
+
+// Picture starts a <picture> element with optional children.
+func Picture(children ...x.Elem) PictureBuilder {
+	return PictureBuilder{newBase(x.Picture(children...), func(b base[PictureBuilder]) PictureBuilder {
+		return PictureBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b PictureBuilder) Children(children ...x.Elem) PictureBuilder {
+	return b.add(children...)
+}
+
+// SourceBuilder builds a <source> element.
+type SourceBuilder struct{ base[SourceBuilder] }
+
+// Source starts a <source> (media source) element. This is a self-closing tag, so it takes no children.
+func Source() SourceBuilder {
+	return SourceBuilder{newBase(x.Source(), func(b base[SourceBuilder]) SourceBuilder {
+		return SourceBuilder{b}
+	})}
+}
+
+// Type sets the type attribute.
+func (b SourceBuilder) Type(value string) SourceBuilder {
+	return b.add(x.Att("type", value))
+}
+
+// Media sets the media attribute.
+func (b SourceBuilder) Media(value string) SourceBuilder {
+	return b.add(x.Att("media", value))
+}
+
+// Src sets the src attribute.
+func (b SourceBuilder) Src(value string) SourceBuilder {
+	return b.add(x.Att("src", value))
+}
+
+// SrcSet sets the srcset attribute.
+func (b SourceBuilder) SrcSet(value string) SourceBuilder {
+	return b.add(x.Att("srcset", value))
+}
+
+// Sizes sets the sizes attribute.
+func (b SourceBuilder) Sizes(value string) SourceBuilder {
+	return b.add(x.Att("sizes", value))
+}
+
+// Width sets the width attribute to an integer.
+func (b SourceBuilder) Width(n int) SourceBuilder {
+	return b.add(x.AttInt("width", n))
+}
+
+// Height sets the height attribute to an integer.
+func (b SourceBuilder) Height(n int) SourceBuilder {
+	return b.add(x.AttInt("height", n))
+}
+
+// ImgBuilder builds an <img> element.
+type ImgBuilder struct{ base[ImgBuilder] }
+
+// Img starts an <img> (image) element. This is a self-closing tag, so it takes no children.
+func Img() ImgBuilder {
+	return ImgBuilder{newBase(x.Img(), func(b base[ImgBuilder]) ImgBuilder {
+		return ImgBuilder{b}
+	})}
+}
+
+// Alt sets the alt attribute.
+func (b ImgBuilder) Alt(value string) ImgBuilder {
+	return b.add(x.Att("alt", value))
+}
+
+// Src sets the src attribute.
+func (b ImgBuilder) Src(value string) ImgBuilder {
+	return b.add(x.Att("src", value))
+}
+
+// SrcSet sets the srcset attribute.
+func (b ImgBuilder) SrcSet(value string) ImgBuilder {
+	return b.add(x.Att("srcset", value))
+}
+
+// Sizes sets the sizes attribute.
+func (b ImgBuilder) Sizes(value string) ImgBuilder {
+	return b.add(x.Att("sizes", value))
+}
+
+// CrossOrigin sets the crossorigin attribute.
+func (b ImgBuilder) CrossOrigin(value CrossOrigin) ImgBuilder {
+	return b.add(x.Att("crossorigin", string(value)))
+}
+
+// UseMap sets the usemap attribute.
+func (b ImgBuilder) UseMap(value string) ImgBuilder {
+	return b.add(x.Att("usemap", value))
+}
+
+// IsMap sets the boolean ismap attribute.
+func (b ImgBuilder) IsMap() ImgBuilder {
+	return b.add(x.AttBool("ismap", true))
+}
+
+// Width sets the width attribute to an integer.
+func (b ImgBuilder) Width(n int) ImgBuilder {
+	return b.add(x.AttInt("width", n))
+}
+
+// Height sets the height attribute to an integer.
+func (b ImgBuilder) Height(n int) ImgBuilder {
+	return b.add(x.AttInt("height", n))
+}
+
+// ReferrerPolicy sets the referrerpolicy attribute.
+func (b ImgBuilder) ReferrerPolicy(value ReferrerPolicy) ImgBuilder {
+	return b.add(x.Att("referrerpolicy", string(value)))
+}
+
+// Decoding sets the decoding attribute.
+func (b ImgBuilder) Decoding(value Decoding) ImgBuilder {
+	return b.add(x.Att("decoding", string(value)))
+}
+
+// Loading sets the loading attribute.
+func (b ImgBuilder) Loading(value Loading) ImgBuilder {
+	return b.add(x.Att("loading", string(value)))
+}
+
+// FetchPriority sets the fetchpriority attribute.
+func (b ImgBuilder) FetchPriority(value FetchPriority) ImgBuilder {
+	return b.add(x.Att("fetchpriority", string(value)))
+}
+
+// IframeBuilder builds an <iframe> element.
+type IframeBuilder struct{ base[IframeBuilder] }
+
+// Iframe starts an <iframe> (inline frame) element with optional children.
+func Iframe(children ...x.Elem) IframeBuilder {
+	return IframeBuilder{newBase(x.Iframe(children...), func(b base[IframeBuilder]) IframeBuilder {
+		return IframeBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b IframeBuilder) Children(children ...x.Elem) IframeBuilder {
+	return b.add(children...)
+}
+
+// Src sets the src attribute.
+func (b IframeBuilder) Src(value string) IframeBuilder {
+	return b.add(x.Att("src", value))
+}
+
+// SrcDoc sets the srcdoc attribute.
+func (b IframeBuilder) SrcDoc(value string) IframeBuilder {
+	return b.add(x.Att("srcdoc", value))
+}
+
+// Name sets the name attribute.
+func (b IframeBuilder) Name(value string) IframeBuilder {
+	return b.add(x.Att("name", value))
+}
+
+// Sandbox sets the sandbox attribute to values separated by spaces.
+func (b IframeBuilder) Sandbox(values ...Sandbox) IframeBuilder {
+	return b.add(x.Att("sandbox", joinTokens(values)))
+}
+
+// Allow sets the allow attribute.
+func (b IframeBuilder) Allow(value string) IframeBuilder {
+	return b.add(x.Att("allow", value))
+}
+
+// AllowFullscreen sets the boolean allowfullscreen attribute.
+func (b IframeBuilder) AllowFullscreen() IframeBuilder {
+	return b.add(x.AttBool("allowfullscreen", true))
+}
+
+// Width sets the width attribute to an integer.
+func (b IframeBuilder) Width(n int) IframeBuilder {
+	return b.add(x.AttInt("width", n))
+}
+
+// Height sets the height attribute to an integer.
+func (b IframeBuilder) Height(n int) IframeBuilder {
+	return b.add(x.AttInt("height", n))
+}
+
+// ReferrerPolicy sets the referrerpolicy attribute.
+func (b IframeBuilder) ReferrerPolicy(value ReferrerPolicy) IframeBuilder {
+	return b.add(x.Att("referrerpolicy", string(value)))
+}
+
+// Loading sets the loading attribute.
+func (b IframeBuilder) Loading(value Loading) IframeBuilder {
+	return b.add(x.Att("loading", string(value)))
+}
+
+// EmbedBuilder builds an <embed> element.
+type EmbedBuilder struct{ base[EmbedBuilder] }
+
+// Embed starts an <embed> (embedded content) element. This is a self-closing tag, so it takes no children.
+func Embed() EmbedBuilder {
+	return EmbedBuilder{newBase(x.Embed(), func(b base[EmbedBuilder]) EmbedBuilder {
+		return EmbedBuilder{b}
+	})}
+}
+
+// Src sets the src attribute.
+func (b EmbedBuilder) Src(value string) EmbedBuilder {
+	return b.add(x.Att("src", value))
+}
+
+// Type sets the type attribute.
+func (b EmbedBuilder) Type(value string) EmbedBuilder {
+	return b.add(x.Att("type", value))
+}
+
+// Width sets the width attribute to an integer.
+func (b EmbedBuilder) Width(n int) EmbedBuilder {
+	return b.add(x.AttInt("width", n))
+}
+
+// Height sets the height attribute to an integer.
+func (b EmbedBuilder) Height(n int) EmbedBuilder {
+	return b.add(x.AttInt("height", n))
+}
+
+// ObjectBuilder builds an <object> element.
+type ObjectBuilder struct{ base[ObjectBuilder] }
+
+// Object starts an <object> (external object) element with optional children.
+func Object(children ...x.Elem) ObjectBuilder {
+	return ObjectBuilder{newBase(x.Object(children...), func(b base[ObjectBuilder]) ObjectBuilder {
+		return ObjectBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b ObjectBuilder) Children(children ...x.Elem) ObjectBuilder {
+	return b.add(children...)
+}
+
+// DataAttr sets the data attribute.
+func (b ObjectBuilder) DataAttr(value string) ObjectBuilder {
+	return b.add(x.Att("data", value))
+}
+
+// Type sets the type attribute.
+func (b ObjectBuilder) Type(value string) ObjectBuilder {
+	return b.add(x.Att("type", value))
+}
+
+// Name sets the name attribute.
+func (b ObjectBuilder) Name(value string) ObjectBuilder {
+	return b.add(x.Att("name", value))
+}
+
+// Form sets the form attribute.
+func (b ObjectBuilder) Form(value string) ObjectBuilder {
+	return b.add(x.Att("form", value))
+}
+
+// Width sets the width attribute to an integer.
+func (b ObjectBuilder) Width(n int) ObjectBuilder {
+	return b.add(x.AttInt("width", n))
+}
+
+// Height sets the height attribute to an integer.
+func (b ObjectBuilder) Height(n int) ObjectBuilder {
+	return b.add(x.AttInt("height", n))
+}
+
+// VideoBuilder builds a <video> element.
+type VideoBuilder struct{ base[VideoBuilder] }
+
+// Video starts a <video> element with optional children.
+func Video(children ...x.Elem) VideoBuilder {
+	return VideoBuilder{newBase(x.Video(children...), func(b base[VideoBuilder]) VideoBuilder {
+		return VideoBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b VideoBuilder) Children(children ...x.Elem) VideoBuilder {
+	return b.add(children...)
+}
+
+// Src sets the src attribute.
+func (b VideoBuilder) Src(value string) VideoBuilder {
+	return b.add(x.Att("src", value))
+}
+
+// CrossOrigin sets the crossorigin attribute.
+func (b VideoBuilder) CrossOrigin(value CrossOrigin) VideoBuilder {
+	return b.add(x.Att("crossorigin", string(value)))
+}
+
+// Preload sets the preload attribute.
+func (b VideoBuilder) Preload(value Preload) VideoBuilder {
+	return b.add(x.Att("preload", string(value)))
+}
+
+// AutoPlay sets the boolean autoplay attribute.
+func (b VideoBuilder) AutoPlay() VideoBuilder {
+	return b.add(x.AttBool("autoplay", true))
+}
+
+// Loop sets the boolean loop attribute.
+func (b VideoBuilder) Loop() VideoBuilder {
+	return b.add(x.AttBool("loop", true))
+}
+
+// Muted sets the boolean muted attribute.
+func (b VideoBuilder) Muted() VideoBuilder {
+	return b.add(x.AttBool("muted", true))
+}
+
+// Controls sets the boolean controls attribute.
+func (b VideoBuilder) Controls() VideoBuilder {
+	return b.add(x.AttBool("controls", true))
+}
+
+// Poster sets the poster attribute.
+func (b VideoBuilder) Poster(value string) VideoBuilder {
+	return b.add(x.Att("poster", value))
+}
+
+// PlaysInline sets the boolean playsinline attribute.
+func (b VideoBuilder) PlaysInline() VideoBuilder {
+	return b.add(x.AttBool("playsinline", true))
+}
+
+// Width sets the width attribute to an integer.
+func (b VideoBuilder) Width(n int) VideoBuilder {
+	return b.add(x.AttInt("width", n))
+}
+
+// Height sets the height attribute to an integer.
+func (b VideoBuilder) Height(n int) VideoBuilder {
+	return b.add(x.AttInt("height", n))
+}
+
+// AudioBuilder builds an <audio> element.
+type AudioBuilder struct{ base[AudioBuilder] }
+
+// Audio starts an <audio> element with optional children.
+func Audio(children ...x.Elem) AudioBuilder {
+	return AudioBuilder{newBase(x.Audio(children...), func(b base[AudioBuilder]) AudioBuilder {
+		return AudioBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b AudioBuilder) Children(children ...x.Elem) AudioBuilder {
+	return b.add(children...)
+}
+
+// Src sets the src attribute.
+func (b AudioBuilder) Src(value string) AudioBuilder {
+	return b.add(x.Att("src", value))
+}
+
+// CrossOrigin sets the crossorigin attribute.
+func (b AudioBuilder) CrossOrigin(value CrossOrigin) AudioBuilder {
+	return b.add(x.Att("crossorigin", string(value)))
+}
+
+// Preload sets the preload attribute.
+func (b AudioBuilder) Preload(value Preload) AudioBuilder {
+	return b.add(x.Att("preload", string(value)))
+}
+
+// AutoPlay sets the boolean autoplay attribute.
+func (b AudioBuilder) AutoPlay() AudioBuilder {
+	return b.add(x.AttBool("autoplay", true))
+}
+
+// Loop sets the boolean loop attribute.
+func (b AudioBuilder) Loop() AudioBuilder {
+	return b.add(x.AttBool("loop", true))
+}
+
+// Muted sets the boolean muted attribute.
+func (b AudioBuilder) Muted() AudioBuilder {
+	return b.add(x.AttBool("muted", true))
+}
+
+// Controls sets the boolean controls attribute.
+func (b AudioBuilder) Controls() AudioBuilder {
+	return b.add(x.AttBool("controls", true))
+}
+
+// TrackBuilder builds a <track> element.
+type TrackBuilder struct{ base[TrackBuilder] }
+
+// Track starts a <track> (text track) element. This is a self-closing tag, so it takes no children.
+func Track() TrackBuilder {
+	return TrackBuilder{newBase(x.Track(), func(b base[TrackBuilder]) TrackBuilder {
+		return TrackBuilder{b}
+	})}
+}
+
+// Default sets the boolean default attribute.
+func (b TrackBuilder) Default() TrackBuilder {
+	return b.add(x.AttBool("default", true))
+}
+
+// Kind sets the kind attribute.
+func (b TrackBuilder) Kind(value Kind) TrackBuilder {
+	return b.add(x.Att("kind", string(value)))
+}
+
+// Label sets the label attribute.
+func (b TrackBuilder) Label(value string) TrackBuilder {
+	return b.add(x.Att("label", value))
+}
+
+// Src sets the src attribute.
+func (b TrackBuilder) Src(value string) TrackBuilder {
+	return b.add(x.Att("src", value))
+}
+
+// SrcLang sets the srclang attribute.
+func (b TrackBuilder) SrcLang(value string) TrackBuilder {
+	return b.add(x.Att("srclang", value))
+}
+
+// MapBuilder builds a <map> element.
+type MapBuilder struct{ base[MapBuilder] }
+
+// Map starts a <map> (image map) element with optional children.
+func Map(children ...x.Elem) MapBuilder {
+	return MapBuilder{newBase(x.Map(children...), func(b base[MapBuilder]) MapBuilder {
+		return MapBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b MapBuilder) Children(children ...x.Elem) MapBuilder {
+	return b.add(children...)
+}
+
+// Name sets the name attribute.
+func (b MapBuilder) Name(value string) MapBuilder {
+	return b.add(x.Att("name", value))
+}
+
+// AreaBuilder builds an <area> element.
+type AreaBuilder struct{ base[AreaBuilder] }
+
+// Area starts an <area> (image map area) element. This is a self-closing tag, so it takes no children.
+func Area() AreaBuilder {
+	return AreaBuilder{newBase(x.Area(), func(b base[AreaBuilder]) AreaBuilder {
+		return AreaBuilder{b}
+	})}
+}
+
+// Alt sets the alt attribute.
+func (b AreaBuilder) Alt(value string) AreaBuilder {
+	return b.add(x.Att("alt", value))
+}
+
+// Coords sets the coords attribute.
+func (b AreaBuilder) Coords(value string) AreaBuilder {
+	return b.add(x.Att("coords", value))
+}
+
+// Shape sets the shape attribute.
+func (b AreaBuilder) Shape(value Shape) AreaBuilder {
+	return b.add(x.Att("shape", string(value)))
+}
+
+// Href sets the href attribute.
+func (b AreaBuilder) Href(value string) AreaBuilder {
+	return b.add(x.Att("href", value))
+}
+
+// Target sets the target attribute.
+func (b AreaBuilder) Target(value Target) AreaBuilder {
+	return b.add(x.Att("target", string(value)))
+}
+
+// Download sets the download attribute.
+func (b AreaBuilder) Download(value string) AreaBuilder {
+	return b.add(x.Att("download", value))
+}
+
+// Ping sets the ping attribute.
+func (b AreaBuilder) Ping(value string) AreaBuilder {
+	return b.add(x.Att("ping", value))
+}
+
+// Rel sets the rel attribute to values separated by spaces.
+func (b AreaBuilder) Rel(values ...Rel) AreaBuilder {
+	return b.add(x.Att("rel", joinTokens(values)))
+}
+
+// HrefLang sets the hreflang attribute.
+func (b AreaBuilder) HrefLang(value string) AreaBuilder {
+	return b.add(x.Att("hreflang", value))
+}
+
+// Type sets the type attribute.
+func (b AreaBuilder) Type(value string) AreaBuilder {
+	return b.add(x.Att("type", value))
+}
+
+// ReferrerPolicy sets the referrerpolicy attribute.
+func (b AreaBuilder) ReferrerPolicy(value ReferrerPolicy) AreaBuilder {
+	return b.add(x.Att("referrerpolicy", string(value)))
+}
+
+// MathBuilder builds a <math> element.
+type MathBuilder struct{ base[MathBuilder] }
+
+// Math starts a <math> (MathML math) element with optional children.
+func Math(children ...x.Elem) MathBuilder {
+	return MathBuilder{newBase(x.Math(children...), func(b base[MathBuilder]) MathBuilder {
+		return MathBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b MathBuilder) Children(children ...x.Elem) MathBuilder {
+	return b.add(children...)
+}
+
+// SvgBuilder builds a <svg> element.
+type SvgBuilder struct{ base[SvgBuilder] }
+
+// Svg starts a <svg> (SVG graphics) element with optional children.
+func Svg(children ...x.Elem) SvgBuilder {
+	return SvgBuilder{newBase(x.Svg(children...), func(b base[SvgBuilder]) SvgBuilder {
+		return SvgBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b SvgBuilder) Children(children ...x.Elem) SvgBuilder {
+	return b.add(children...)
+}
+
+// TableBuilder builds a <table> element.
+type TableBuilder struct{ base[TableBuilder] }
+
+// Table starts a <table> element with optional children.
+func Table(children ...x.Elem) TableBuilder {
+	return TableBuilder{newBase(x.Table(children...), func(b base[TableBuilder]) TableBuilder {
+		return TableBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b TableBuilder) Children(children ...x.Elem) TableBuilder {
+	return b.add(children...)
+}
+
+// CaptionBuilder builds a <caption> element.
+type CaptionBuilder struct{ base[CaptionBuilder] }
+
+// Caption starts a <caption> (table caption) element with optional children.
+func Caption(children ...x.Elem) CaptionBuilder {
+	return CaptionBuilder{newBase(x.Caption(children...), func(b base[CaptionBuilder]) CaptionBuilder {
+		return CaptionBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b CaptionBuilder) Children(children ...x.Elem) CaptionBuilder {
+	return b.add(children...)
+}
+
+// ColgroupBuilder builds a <colgroup> element.
+type ColgroupBuilder struct{ base[ColgroupBuilder] }
+
+// Colgroup starts a <colgroup> (table column group) element with optional children.
+func Colgroup(children ...x.Elem) ColgroupBuilder {
+	return ColgroupBuilder{newBase(x.Colgroup(children...), func(b base[ColgroupBuilder]) ColgroupBuilder {
+		return ColgroupBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b ColgroupBuilder) Children(children ...x.Elem) ColgroupBuilder {
+	return b.add(children...)
+}
+
+// Span sets the span attribute to an integer.
+func (b ColgroupBuilder) Span(n int) ColgroupBuilder {
+	return b.add(x.AttInt("span", n))
+}
+
+// ColBuilder builds a <col> element.
+type ColBuilder struct{ base[ColBuilder] }
+
+// Col starts a <col> (table column) element. This is a self-closing tag, so it takes no children.
+func Col() ColBuilder {
+	return ColBuilder{newBase(x.Col(), func(b base[ColBuilder]) ColBuilder {
+		return ColBuilder{b}
+	})}
+}
+
+// Span sets the span attribute to an integer.
+func (b ColBuilder) Span(n int) ColBuilder {
+	return b.add(x.AttInt("span", n))
+}
+
+// TbodyBuilder builds a <tbody> element.
+type TbodyBuilder struct{ base[TbodyBuilder] }
+
+// Tbody starts a <tbody> (table body) element with optional children.
+func Tbody(children ...x.Elem) TbodyBuilder {
+	return TbodyBuilder{newBase(x.Tbody(children...), func(b base[TbodyBuilder]) TbodyBuilder {
+		return TbodyBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b TbodyBuilder) Children(children ...x.Elem) TbodyBuilder {
+	return b.add(children...)
+}
+
+// TheadBuilder builds a <thead> element.
+type TheadBuilder struct{ base[TheadBuilder] }
+
+// Thead starts a <thead> (table head) element with optional children.
+func Thead(children ...x.Elem) TheadBuilder {
+	return TheadBuilder{newBase(x.Thead(children...), func(b base[TheadBuilder]) TheadBuilder {
+		return TheadBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b TheadBuilder) Children(children ...x.Elem) TheadBuilder {
+	return b.add(children...)
+}
+
+// TfootBuilder builds a <tfoot> element.
+type TfootBuilder struct{ base[TfootBuilder] }
+
+// Tfoot starts a <tfoot> (table foot) element with optional children.
+func Tfoot(children ...x.Elem) TfootBuilder {
+	return TfootBuilder{newBase(x.Tfoot(children...), func(b base[TfootBuilder]) TfootBuilder {
+		return TfootBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b TfootBuilder) Children(children ...x.Elem) TfootBuilder {
+	return b.add(children...)
+}
+
+// TrBuilder builds a <tr> element.
+type TrBuilder struct{ base[TrBuilder] }
+
+// Tr starts a <tr> (table row) element with optional children.
+func Tr(children ...x.Elem) TrBuilder {
+	return TrBuilder{newBase(x.Tr(children...), func(b base[TrBuilder]) TrBuilder {
+		return TrBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b TrBuilder) Children(children ...x.Elem) TrBuilder {
+	return b.add(children...)
+}
+
+// TdBuilder builds a <td> element.
+type TdBuilder struct{ base[TdBuilder] }
+
+// Td starts a <td> (table cell) element with optional children.
+func Td(children ...x.Elem) TdBuilder {
+	return TdBuilder{newBase(x.Td(children...), func(b base[TdBuilder]) TdBuilder {
+		return TdBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b TdBuilder) Children(children ...x.Elem) TdBuilder {
+	return b.add(children...)
+}
+
+// ColSpan sets the colspan attribute to an integer.
+func (b TdBuilder) ColSpan(n int) TdBuilder {
+	return b.add(x.AttInt("colspan", n))
+}
+
+// RowSpan sets the rowspan attribute to an integer.
+func (b TdBuilder) RowSpan(n int) TdBuilder {
+	return b.add(x.AttInt("rowspan", n))
+}
+
+// Headers sets the headers attribute.
+func (b TdBuilder) Headers(value string) TdBuilder {
+	return b.add(x.Att("headers", value))
+}
+
+// ThBuilder builds a <th> element.
+type ThBuilder struct{ base[ThBuilder] }
+
+// Th starts a <th> (table header cell) element with optional children.
+func Th(children ...x.Elem) ThBuilder {
+	return ThBuilder{newBase(x.Th(children...), func(b base[ThBuilder]) ThBuilder {
+		return ThBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b ThBuilder) Children(children ...x.Elem) ThBuilder {
+	return b.add(children...)
+}
+
+// ColSpan sets the colspan attribute to an integer.
+func (b ThBuilder) ColSpan(n int) ThBuilder {
+	return b.add(x.AttInt("colspan", n))
+}
+
+// RowSpan sets the rowspan attribute to an integer.
+func (b ThBuilder) RowSpan(n int) ThBuilder {
+	return b.add(x.AttInt("rowspan", n))
+}
+
+// Headers sets the headers attribute.
+func (b ThBuilder) Headers(value string) ThBuilder {
+	return b.add(x.Att("headers", value))
+}
+
+// Scope sets the scope attribute.
+func (b ThBuilder) Scope(value Scope) ThBuilder {
+	return b.add(x.Att("scope", string(value)))
+}
+
+// Abbr sets the abbr attribute.
+func (b ThBuilder) Abbr(value string) ThBuilder {
+	return b.add(x.Att("abbr", value))
+}
+
+// FormBuilder builds a <form> element.
+type FormBuilder struct{ base[FormBuilder] }
+
+// Form starts a <form> element with optional children.
+func Form(children ...x.Elem) FormBuilder {
+	return FormBuilder{newBase(x.Form(children...), func(b base[FormBuilder]) FormBuilder {
+		return FormBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b FormBuilder) Children(children ...x.Elem) FormBuilder {
+	return b.add(children...)
+}
+
+// AcceptCharset sets the accept-charset attribute.
+func (b FormBuilder) AcceptCharset(value AcceptCharset) FormBuilder {
+	return b.add(x.Att("accept-charset", string(value)))
+}
+
+// Action sets the action attribute.
+func (b FormBuilder) Action(value string) FormBuilder {
+	return b.add(x.Att("action", value))
+}
+
+// AutoComplete sets the autocomplete attribute.
+func (b FormBuilder) AutoComplete(value AutoComplete) FormBuilder {
+	return b.add(x.Att("autocomplete", string(value)))
+}
+
+// EncType sets the enctype attribute.
+func (b FormBuilder) EncType(value EncType) FormBuilder {
+	return b.add(x.Att("enctype", string(value)))
+}
+
+// Method sets the method attribute.
+func (b FormBuilder) Method(value Method) FormBuilder {
+	return b.add(x.Att("method", string(value)))
+}
+
+// Name sets the name attribute.
+func (b FormBuilder) Name(value string) FormBuilder {
+	return b.add(x.Att("name", value))
+}
+
+// NoValidate sets the boolean novalidate attribute.
+func (b FormBuilder) NoValidate() FormBuilder {
+	return b.add(x.AttBool("novalidate", true))
+}
+
+// Rel sets the rel attribute to values separated by spaces.
+func (b FormBuilder) Rel(values ...Rel) FormBuilder {
+	return b.add(x.Att("rel", joinTokens(values)))
+}
+
+// Target sets the target attribute.
+func (b FormBuilder) Target(value Target) FormBuilder {
+	return b.add(x.Att("target", string(value)))
+}
+
+// LabelBuilder builds a <label> element.
+type LabelBuilder struct{ base[LabelBuilder] }
+
+// Label starts a <label> element with optional children.
+func Label(children ...x.Elem) LabelBuilder {
+	return LabelBuilder{newBase(x.Label(children...), func(b base[LabelBuilder]) LabelBuilder {
+		return LabelBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b LabelBuilder) Children(children ...x.Elem) LabelBuilder {
+	return b.add(children...)
+}
+
+// For sets the for attribute.
+func (b LabelBuilder) For(value string) LabelBuilder {
+	return b.add(x.Att("for", value))
+}
+
+// InputBuilder builds an <input> element.
+type InputBuilder struct{ base[InputBuilder] }
+
+// Input starts an <input> element. This is a self-closing tag, so it takes no children.
+func Input() InputBuilder {
+	return InputBuilder{newBase(x.Input(), func(b base[InputBuilder]) InputBuilder {
+		return InputBuilder{b}
+	})}
+}
+
+// FormAction sets the formaction attribute.
+func (b InputBuilder) FormAction(value string) InputBuilder {
+	return b.add(x.Att("formaction", value))
+}
+
+// FormEncType sets the formenctype attribute.
+func (b InputBuilder) FormEncType(value EncType) InputBuilder {
+	return b.add(x.Att("formenctype", string(value)))
+}
+
+// FormMethod sets the formmethod attribute.
+func (b InputBuilder) FormMethod(value Method) InputBuilder {
+	return b.add(x.Att("formmethod", string(value)))
+}
+
+// FormNoValidate sets the boolean formnovalidate attribute.
+func (b InputBuilder) FormNoValidate() InputBuilder {
+	return b.add(x.AttBool("formnovalidate", true))
+}
+
+// FormTarget sets the formtarget attribute.
+func (b InputBuilder) FormTarget(value Target) InputBuilder {
+	return b.add(x.Att("formtarget", string(value)))
+}
+
+// AutoComplete sets the autocomplete attribute.
+func (b InputBuilder) AutoComplete(value AutoComplete) InputBuilder {
+	return b.add(x.Att("autocomplete", string(value)))
+}
+
+// DirName sets the dirname attribute.
+func (b InputBuilder) DirName(value string) InputBuilder {
+	return b.add(x.Att("dirname", value))
+}
+
+// Disabled sets the boolean disabled attribute.
+func (b InputBuilder) Disabled() InputBuilder {
+	return b.add(x.AttBool("disabled", true))
+}
+
+// Form sets the form attribute.
+func (b InputBuilder) Form(value string) InputBuilder {
+	return b.add(x.Att("form", value))
+}
+
+// MaxLength sets the maxlength attribute to an integer.
+func (b InputBuilder) MaxLength(n int) InputBuilder {
+	return b.add(x.AttInt("maxlength", n))
+}
+
+// MinLength sets the minlength attribute to an integer.
+func (b InputBuilder) MinLength(n int) InputBuilder {
+	return b.add(x.AttInt("minlength", n))
+}
+
+// Name sets the name attribute.
+func (b InputBuilder) Name(value string) InputBuilder {
+	return b.add(x.Att("name", value))
+}
+
+// Placeholder sets the placeholder attribute.
+func (b InputBuilder) Placeholder(value string) InputBuilder {
+	return b.add(x.Att("placeholder", value))
+}
+
+// ReadOnly sets the boolean readonly attribute.
+func (b InputBuilder) ReadOnly() InputBuilder {
+	return b.add(x.AttBool("readonly", true))
+}
+
+// Required sets the boolean required attribute.
+func (b InputBuilder) Required() InputBuilder {
+	return b.add(x.AttBool("required", true))
+}
+
+// PopoverTarget sets the popovertarget attribute.
+func (b InputBuilder) PopoverTarget(value string) InputBuilder {
+	return b.add(x.Att("popovertarget", value))
+}
+
+// PopoverTargetAction sets the popovertargetaction attribute.
+func (b InputBuilder) PopoverTargetAction(value PopoverTargetAction) InputBuilder {
+	return b.add(x.Att("popovertargetaction", string(value)))
+}
+
+// Accept sets the accept attribute.
+func (b InputBuilder) Accept(value string) InputBuilder {
+	return b.add(x.Att("accept", value))
+}
+
+// Alpha sets the boolean alpha attribute.
+func (b InputBuilder) Alpha() InputBuilder {
+	return b.add(x.AttBool("alpha", true))
+}
+
+// Alt sets the alt attribute.
+func (b InputBuilder) Alt(value string) InputBuilder {
+	return b.add(x.Att("alt", value))
+}
+
+// Checked sets the boolean checked attribute.
+func (b InputBuilder) Checked() InputBuilder {
+	return b.add(x.AttBool("checked", true))
+}
+
+// ColorSpace sets the colorspace attribute.
+func (b InputBuilder) ColorSpace(value ColorSpace) InputBuilder {
+	return b.add(x.Att("colorspace", string(value)))
+}
+
+// Height sets the height attribute to an integer.
+func (b InputBuilder) Height(n int) InputBuilder {
+	return b.add(x.AttInt("height", n))
+}
+
+// List sets the list attribute.
+func (b InputBuilder) List(value string) InputBuilder {
+	return b.add(x.Att("list", value))
+}
+
+// Max sets the max attribute.
+func (b InputBuilder) Max(value string) InputBuilder {
+	return b.add(x.Att("max", value))
+}
+
+// Min sets the min attribute.
+func (b InputBuilder) Min(value string) InputBuilder {
+	return b.add(x.Att("min", value))
+}
+
+// Multiple sets the boolean multiple attribute.
+func (b InputBuilder) Multiple() InputBuilder {
+	return b.add(x.AttBool("multiple", true))
+}
+
+// Pattern sets the pattern attribute.
+func (b InputBuilder) Pattern(value string) InputBuilder {
+	return b.add(x.Att("pattern", value))
+}
+
+// Size sets the size attribute to an integer.
+func (b InputBuilder) Size(n int) InputBuilder {
+	return b.add(x.AttInt("size", n))
+}
+
+// Src sets the src attribute.
+func (b InputBuilder) Src(value string) InputBuilder {
+	return b.add(x.Att("src", value))
+}
+
+// Step sets the step attribute.
+func (b InputBuilder) Step(value Step) InputBuilder {
+	return b.add(x.Att("step", string(value)))
+}
+
+// Type sets the type attribute.
+func (b InputBuilder) Type(value InputType) InputBuilder {
+	return b.add(x.Att("type", string(value)))
+}
+
+// Value sets the value attribute.
+func (b InputBuilder) Value(value string) InputBuilder {
+	return b.add(x.Att("value", value))
+}
+
+// Width sets the width attribute to an integer.
+func (b InputBuilder) Width(n int) InputBuilder {
+	return b.add(x.AttInt("width", n))
+}
+
+// ButtonBuilder builds a <button> element.
+type ButtonBuilder struct{ base[ButtonBuilder] }
+
+// Button starts a <button> element with optional children.
+func Button(children ...x.Elem) ButtonBuilder {
+	return ButtonBuilder{newBase(x.Button(children...), func(b base[ButtonBuilder]) ButtonBuilder {
+		return ButtonBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b ButtonBuilder) Children(children ...x.Elem) ButtonBuilder {
+	return b.add(children...)
+}
+
+// FormAction sets the formaction attribute.
+func (b ButtonBuilder) FormAction(value string) ButtonBuilder {
+	return b.add(x.Att("formaction", value))
+}
+
+// FormEncType sets the formenctype attribute.
+func (b ButtonBuilder) FormEncType(value EncType) ButtonBuilder {
+	return b.add(x.Att("formenctype", string(value)))
+}
+
+// FormMethod sets the formmethod attribute.
+func (b ButtonBuilder) FormMethod(value Method) ButtonBuilder {
+	return b.add(x.Att("formmethod", string(value)))
+}
+
+// FormNoValidate sets the boolean formnovalidate attribute.
+func (b ButtonBuilder) FormNoValidate() ButtonBuilder {
+	return b.add(x.AttBool("formnovalidate", true))
+}
+
+// FormTarget sets the formtarget attribute.
+func (b ButtonBuilder) FormTarget(value Target) ButtonBuilder {
+	return b.add(x.Att("formtarget", string(value)))
+}
+
+// PopoverTarget sets the popovertarget attribute.
+func (b ButtonBuilder) PopoverTarget(value string) ButtonBuilder {
+	return b.add(x.Att("popovertarget", value))
+}
+
+// PopoverTargetAction sets the popovertargetaction attribute.
+func (b ButtonBuilder) PopoverTargetAction(value PopoverTargetAction) ButtonBuilder {
+	return b.add(x.Att("popovertargetaction", string(value)))
+}
+
+// Command sets the command attribute.
+func (b ButtonBuilder) Command(value Command) ButtonBuilder {
+	return b.add(x.Att("command", string(value)))
+}
+
+// CommandFor sets the commandfor attribute.
+func (b ButtonBuilder) CommandFor(value string) ButtonBuilder {
+	return b.add(x.Att("commandfor", value))
+}
+
+// Disabled sets the boolean disabled attribute.
+func (b ButtonBuilder) Disabled() ButtonBuilder {
+	return b.add(x.AttBool("disabled", true))
+}
+
+// Form sets the form attribute.
+func (b ButtonBuilder) Form(value string) ButtonBuilder {
+	return b.add(x.Att("form", value))
+}
+
+// Name sets the name attribute.
+func (b ButtonBuilder) Name(value string) ButtonBuilder {
+	return b.add(x.Att("name", value))
+}
+
+// Type sets the type attribute.
+func (b ButtonBuilder) Type(value ButtonType) ButtonBuilder {
+	return b.add(x.Att("type", string(value)))
+}
+
+// Value sets the value attribute.
+func (b ButtonBuilder) Value(value string) ButtonBuilder {
+	return b.add(x.Att("value", value))
+}
+
+// SelectBuilder builds a <select> element.
+type SelectBuilder struct{ base[SelectBuilder] }
+
+// Select starts a <select> element with optional children.
+func Select(children ...x.Elem) SelectBuilder {
+	return SelectBuilder{newBase(x.Select(children...), func(b base[SelectBuilder]) SelectBuilder {
+		return SelectBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b SelectBuilder) Children(children ...x.Elem) SelectBuilder {
+	return b.add(children...)
+}
+
+// AutoComplete sets the autocomplete attribute.
+func (b SelectBuilder) AutoComplete(value AutoComplete) SelectBuilder {
+	return b.add(x.Att("autocomplete", string(value)))
+}
+
+// Disabled sets the boolean disabled attribute.
+func (b SelectBuilder) Disabled() SelectBuilder {
+	return b.add(x.AttBool("disabled", true))
+}
+
+// Form sets the form attribute.
+func (b SelectBuilder) Form(value string) SelectBuilder {
+	return b.add(x.Att("form", value))
+}
+
+// Multiple sets the boolean multiple attribute.
+func (b SelectBuilder) Multiple() SelectBuilder {
+	return b.add(x.AttBool("multiple", true))
+}
+
+// Name sets the name attribute.
+func (b SelectBuilder) Name(value string) SelectBuilder {
+	return b.add(x.Att("name", value))
+}
+
+// Required sets the boolean required attribute.
+func (b SelectBuilder) Required() SelectBuilder {
+	return b.add(x.AttBool("required", true))
+}
+
+// Size sets the size attribute to an integer.
+func (b SelectBuilder) Size(n int) SelectBuilder {
+	return b.add(x.AttInt("size", n))
+}
+
+// DatalistBuilder builds a <datalist> element.
+type DatalistBuilder struct{ base[DatalistBuilder] }
+
+// Datalist starts a <datalist> element with optional children.
+func Datalist(children ...x.Elem) DatalistBuilder {
+	return DatalistBuilder{newBase(x.Datalist(children...), func(b base[DatalistBuilder]) DatalistBuilder {
+		return DatalistBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b DatalistBuilder) Children(children ...x.Elem) DatalistBuilder {
+	return b.add(children...)
+}
+
+// OptgroupBuilder builds an <optgroup> element.
+type OptgroupBuilder struct{ base[OptgroupBuilder] }
+
+// Optgroup starts an <optgroup> (option group) element with optional children.
+func Optgroup(children ...x.Elem) OptgroupBuilder {
+	return OptgroupBuilder{newBase(x.Optgroup(children...), func(b base[OptgroupBuilder]) OptgroupBuilder {
+		return OptgroupBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b OptgroupBuilder) Children(children ...x.Elem) OptgroupBuilder {
+	return b.add(children...)
+}
+
+// Disabled sets the boolean disabled attribute.
+func (b OptgroupBuilder) Disabled() OptgroupBuilder {
+	return b.add(x.AttBool("disabled", true))
+}
+
+// Label sets the label attribute.
+func (b OptgroupBuilder) Label(value string) OptgroupBuilder {
+	return b.add(x.Att("label", value))
+}
+
+// OptionBuilder builds an <option> element.
+type OptionBuilder struct{ base[OptionBuilder] }
+
+// Option starts an <option> element with optional children.
+func Option(children ...x.Elem) OptionBuilder {
+	return OptionBuilder{newBase(x.Option(children...), func(b base[OptionBuilder]) OptionBuilder {
+		return OptionBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b OptionBuilder) Children(children ...x.Elem) OptionBuilder {
+	return b.add(children...)
+}
+
+// Disabled sets the boolean disabled attribute.
+func (b OptionBuilder) Disabled() OptionBuilder {
+	return b.add(x.AttBool("disabled", true))
+}
+
+// Label sets the label attribute.
+func (b OptionBuilder) Label(value string) OptionBuilder {
+	return b.add(x.Att("label", value))
+}
+
+// Selected sets the boolean selected attribute.
+func (b OptionBuilder) Selected() OptionBuilder {
+	return b.add(x.AttBool("selected", true))
+}
+
+// Value sets the value attribute.
+func (b OptionBuilder) Value(value string) OptionBuilder {
+	return b.add(x.Att("value", value))
+}
+
+// TextareaBuilder builds a <textarea> element.
+type TextareaBuilder struct{ base[TextareaBuilder] }
+
+// Textarea starts a <textarea> (multi-line text input) element with optional children.
+func Textarea(children ...x.Elem) TextareaBuilder {
+	return TextareaBuilder{newBase(x.Textarea(children...), func(b base[TextareaBuilder]) TextareaBuilder {
+		return TextareaBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b TextareaBuilder) Children(children ...x.Elem) TextareaBuilder {
+	return b.add(children...)
+}
+
+// AutoComplete sets the autocomplete attribute.
+func (b TextareaBuilder) AutoComplete(value AutoComplete) TextareaBuilder {
+	return b.add(x.Att("autocomplete", string(value)))
+}
+
+// DirName sets the dirname attribute.
+func (b TextareaBuilder) DirName(value string) TextareaBuilder {
+	return b.add(x.Att("dirname", value))
+}
+
+// Disabled sets the boolean disabled attribute.
+func (b TextareaBuilder) Disabled() TextareaBuilder {
+	return b.add(x.AttBool("disabled", true))
+}
+
+// Form sets the form attribute.
+func (b TextareaBuilder) Form(value string) TextareaBuilder {
+	return b.add(x.Att("form", value))
+}
+
+// MaxLength sets the maxlength attribute to an integer.
+func (b TextareaBuilder) MaxLength(n int) TextareaBuilder {
+	return b.add(x.AttInt("maxlength", n))
+}
+
+// MinLength sets the minlength attribute to an integer.
+func (b TextareaBuilder) MinLength(n int) TextareaBuilder {
+	return b.add(x.AttInt("minlength", n))
+}
+
+// Name sets the name attribute.
+func (b TextareaBuilder) Name(value string) TextareaBuilder {
+	return b.add(x.Att("name", value))
+}
+
+// Placeholder sets the placeholder attribute.
+func (b TextareaBuilder) Placeholder(value string) TextareaBuilder {
+	return b.add(x.Att("placeholder", value))
+}
+
+// ReadOnly sets the boolean readonly attribute.
+func (b TextareaBuilder) ReadOnly() TextareaBuilder {
+	return b.add(x.AttBool("readonly", true))
+}
+
+// Required sets the boolean required attribute.
+func (b TextareaBuilder) Required() TextareaBuilder {
+	return b.add(x.AttBool("required", true))
+}
+
+// Cols sets the cols attribute to an integer.
+func (b TextareaBuilder) Cols(n int) TextareaBuilder {
+	return b.add(x.AttInt("cols", n))
+}
+
+// Rows sets the rows attribute to an integer.
+func (b TextareaBuilder) Rows(n int) TextareaBuilder {
+	return b.add(x.AttInt("rows", n))
+}
+
+// Wrap sets the wrap attribute.
+func (b TextareaBuilder) Wrap(value Wrap) TextareaBuilder {
+	return b.add(x.Att("wrap", string(value)))
+}
+
+// OutputBuilder builds an <output> element.
+type OutputBuilder struct{ base[OutputBuilder] }
+
+// Output starts an <output> element with optional children.
+func Output(children ...x.Elem) OutputBuilder {
+	return OutputBuilder{newBase(x.Output(children...), func(b base[OutputBuilder]) OutputBuilder {
+		return OutputBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b OutputBuilder) Children(children ...x.Elem) OutputBuilder {
+	return b.add(children...)
+}
+
+// For sets the for attribute.
+func (b OutputBuilder) For(value string) OutputBuilder {
+	return b.add(x.Att("for", value))
+}
+
+// Form sets the form attribute.
+func (b OutputBuilder) Form(value string) OutputBuilder {
+	return b.add(x.Att("form", value))
+}
+
+// Name sets the name attribute.
+func (b OutputBuilder) Name(value string) OutputBuilder {
+	return b.add(x.Att("name", value))
+}
+
+// ProgressBuilder builds a <progress> element.
+type ProgressBuilder struct{ base[ProgressBuilder] }
+
+// Progress starts a <progress> (progress indicator) element with optional children.
+func Progress(children ...x.Elem) ProgressBuilder {
+	return ProgressBuilder{newBase(x.Progress(children...), func(b base[ProgressBuilder]) ProgressBuilder {
+		return ProgressBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b ProgressBuilder) Children(children ...x.Elem) ProgressBuilder {
+	return b.add(children...)
+}
+
+// Value sets the value attribute to a number.
+func (b ProgressBuilder) Value(n float64) ProgressBuilder {
+	return b.add(x.AttFloat("value", n))
+}
+
+// Max sets the max attribute to a number.
+func (b ProgressBuilder) Max(n float64) ProgressBuilder {
+	return b.add(x.AttFloat("max", n))
+}
+
+// MeterBuilder builds a <meter> element.
+type MeterBuilder struct{ base[MeterBuilder] }
+
+// Meter starts a <meter> (gauge) element with optional children.
+func Meter(children ...x.Elem) MeterBuilder {
+	return MeterBuilder{newBase(x.Meter(children...), func(b base[MeterBuilder]) MeterBuilder {
+		return MeterBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b MeterBuilder) Children(children ...x.Elem) MeterBuilder {
+	return b.add(children...)
+}
+
+// Value sets the value attribute to a number.
+func (b MeterBuilder) Value(n float64) MeterBuilder {
+	return b.add(x.AttFloat("value", n))
+}
+
+// Min sets the min attribute to a number.
+func (b MeterBuilder) Min(n float64) MeterBuilder {
+	return b.add(x.AttFloat("min", n))
+}
+
+// Max sets the max attribute to a number.
+func (b MeterBuilder) Max(n float64) MeterBuilder {
+	return b.add(x.AttFloat("max", n))
+}
+
+// Low sets the low attribute to a number.
+func (b MeterBuilder) Low(n float64) MeterBuilder {
+	return b.add(x.AttFloat("low", n))
+}
+
+// High sets the high attribute to a number.
+func (b MeterBuilder) High(n float64) MeterBuilder {
+	return b.add(x.AttFloat("high", n))
+}
+
+// Optimum sets the optimum attribute to a number.
+func (b MeterBuilder) Optimum(n float64) MeterBuilder {
+	return b.add(x.AttFloat("optimum", n))
+}
+
+// FieldsetBuilder builds a <fieldset> element.
+type FieldsetBuilder struct{ base[FieldsetBuilder] }
+
+// Fieldset starts a <fieldset> (form control group) element with optional children.
+func Fieldset(children ...x.Elem) FieldsetBuilder {
+	return FieldsetBuilder{newBase(x.Fieldset(children...), func(b base[FieldsetBuilder]) FieldsetBuilder {
+		return FieldsetBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b FieldsetBuilder) Children(children ...x.Elem) FieldsetBuilder {
+	return b.add(children...)
+}
+
+// Disabled sets the boolean disabled attribute.
+func (b FieldsetBuilder) Disabled() FieldsetBuilder {
+	return b.add(x.AttBool("disabled", true))
+}
+
+// Form sets the form attribute.
+func (b FieldsetBuilder) Form(value string) FieldsetBuilder {
+	return b.add(x.Att("form", value))
+}
+
+// Name sets the name attribute.
+func (b FieldsetBuilder) Name(value string) FieldsetBuilder {
+	return b.add(x.Att("name", value))
+}
+
+// LegendBuilder builds a <legend> element.
+type LegendBuilder struct{ base[LegendBuilder] }
+
+// Legend starts a <legend> (fieldset caption) element with optional children.
+func Legend(children ...x.Elem) LegendBuilder {
+	return LegendBuilder{newBase(x.Legend(children...), func(b base[LegendBuilder]) LegendBuilder {
+		return LegendBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b LegendBuilder) Children(children ...x.Elem) LegendBuilder {
+	return b.add(children...)
+}
+
+// DetailsBuilder builds a <details> element.
+type DetailsBuilder struct{ base[DetailsBuilder] }
+
+// Details starts a <details> (disclosure widget) element with optional children.
+func Details(children ...x.Elem) DetailsBuilder {
+	return DetailsBuilder{newBase(x.Details(children...), func(b base[DetailsBuilder]) DetailsBuilder {
+		return DetailsBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b DetailsBuilder) Children(children ...x.Elem) DetailsBuilder {
+	return b.add(children...)
+}
+
+// Name sets the name attribute.
+func (b DetailsBuilder) Name(value string) DetailsBuilder {
+	return b.add(x.Att("name", value))
+}
+
+// Open sets the boolean open attribute.
+func (b DetailsBuilder) Open() DetailsBuilder {
+	return b.add(x.AttBool("open", true))
+}
+
+// SummaryBuilder builds a <summary> element.
+type SummaryBuilder struct{ base[SummaryBuilder] }
+
+// Summary starts a <summary> (disclosure summary) element with optional children.
+func Summary(children ...x.Elem) SummaryBuilder {
+	return SummaryBuilder{newBase(x.Summary(children...), func(b base[SummaryBuilder]) SummaryBuilder {
+		return SummaryBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b SummaryBuilder) Children(children ...x.Elem) SummaryBuilder {
+	return b.add(children...)
+}
+
+// DialogBuilder builds a <dialog> element.
+type DialogBuilder struct{ base[DialogBuilder] }
+
+// Dialog starts a <dialog> element with optional children.
+func Dialog(children ...x.Elem) DialogBuilder {
+	return DialogBuilder{newBase(x.Dialog(children...), func(b base[DialogBuilder]) DialogBuilder {
+		return DialogBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b DialogBuilder) Children(children ...x.Elem) DialogBuilder {
+	return b.add(children...)
+}
+
+// Open sets the boolean open attribute.
+func (b DialogBuilder) Open() DialogBuilder {
+	return b.add(x.AttBool("open", true))
+}
+
+// ClosedBy sets the closedby attribute.
+func (b DialogBuilder) ClosedBy(value ClosedBy) DialogBuilder {
+	return b.add(x.Att("closedby", string(value)))
+}
+
+// ScriptBuilder builds a <script> element.
+type ScriptBuilder struct{ base[ScriptBuilder] }
+
+// Script starts a <script> element with optional children.
+func Script(children ...x.Elem) ScriptBuilder {
+	return ScriptBuilder{newBase(x.Script(children...), func(b base[ScriptBuilder]) ScriptBuilder {
+		return ScriptBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b ScriptBuilder) Children(children ...x.Elem) ScriptBuilder {
+	return b.add(children...)
+}
+
+// Src sets the src attribute.
+func (b ScriptBuilder) Src(value string) ScriptBuilder {
+	return b.add(x.Att("src", value))
+}
+
+// Type sets the type attribute.
+func (b ScriptBuilder) Type(value ScriptType) ScriptBuilder {
+	return b.add(x.Att("type", string(value)))
+}
+
+// NoModule sets the boolean nomodule attribute.
+func (b ScriptBuilder) NoModule() ScriptBuilder {
+	return b.add(x.AttBool("nomodule", true))
+}
+
+// Async sets the boolean async attribute.
+func (b ScriptBuilder) Async() ScriptBuilder {
+	return b.add(x.AttBool("async", true))
+}
+
+// Defer sets the boolean defer attribute.
+func (b ScriptBuilder) Defer() ScriptBuilder {
+	return b.add(x.AttBool("defer", true))
+}
+
+// Blocking sets the blocking attribute to values separated by spaces.
+func (b ScriptBuilder) Blocking(values ...Blocking) ScriptBuilder {
+	return b.add(x.Att("blocking", joinTokens(values)))
+}
+
+// CrossOrigin sets the crossorigin attribute.
+func (b ScriptBuilder) CrossOrigin(value CrossOrigin) ScriptBuilder {
+	return b.add(x.Att("crossorigin", string(value)))
+}
+
+// Integrity sets the integrity attribute.
+func (b ScriptBuilder) Integrity(value string) ScriptBuilder {
+	return b.add(x.Att("integrity", value))
+}
+
+// ReferrerPolicy sets the referrerpolicy attribute.
+func (b ScriptBuilder) ReferrerPolicy(value ReferrerPolicy) ScriptBuilder {
+	return b.add(x.Att("referrerpolicy", string(value)))
+}
+
+// FetchPriority sets the fetchpriority attribute.
+func (b ScriptBuilder) FetchPriority(value FetchPriority) ScriptBuilder {
+	return b.add(x.Att("fetchpriority", string(value)))
+}
+
+// NoscriptBuilder builds a <noscript> element.
+type NoscriptBuilder struct{ base[NoscriptBuilder] }
+
+// Noscript starts a <noscript> (scripting fallback) element with optional children.
+func Noscript(children ...x.Elem) NoscriptBuilder {
+	return NoscriptBuilder{newBase(x.Noscript(children...), func(b base[NoscriptBuilder]) NoscriptBuilder {
+		return NoscriptBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b NoscriptBuilder) Children(children ...x.Elem) NoscriptBuilder {
+	return b.add(children...)
+}
+
+// TemplateBuilder builds a <template> element.
+type TemplateBuilder struct{ base[TemplateBuilder] }
+
+// Template starts a <template> (content template) element with optional children.
+func Template(children ...x.Elem) TemplateBuilder {
+	return TemplateBuilder{newBase(x.Template(children...), func(b base[TemplateBuilder]) TemplateBuilder {
+		return TemplateBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b TemplateBuilder) Children(children ...x.Elem) TemplateBuilder {
+	return b.add(children...)
+}
+
+// ShadowRootMode sets the shadowrootmode attribute.
+func (b TemplateBuilder) ShadowRootMode(value ShadowRootMode) TemplateBuilder {
+	return b.add(x.Att("shadowrootmode", string(value)))
+}
+
+// ShadowRootDelegatesFocus sets the boolean shadowrootdelegatesfocus attribute.
+func (b TemplateBuilder) ShadowRootDelegatesFocus() TemplateBuilder {
+	return b.add(x.AttBool("shadowrootdelegatesfocus", true))
+}
+
+// ShadowRootClonable sets the boolean shadowrootclonable attribute.
+func (b TemplateBuilder) ShadowRootClonable() TemplateBuilder {
+	return b.add(x.AttBool("shadowrootclonable", true))
+}
+
+// ShadowRootSerializable sets the boolean shadowrootserializable attribute.
+func (b TemplateBuilder) ShadowRootSerializable() TemplateBuilder {
+	return b.add(x.AttBool("shadowrootserializable", true))
+}
+
+// SlotBuilder builds a <slot> element.
+type SlotBuilder struct{ base[SlotBuilder] }
+
+// Slot starts a <slot> (shadow tree slot) element with optional children.
+func Slot(children ...x.Elem) SlotBuilder {
+	return SlotBuilder{newBase(x.Slot(children...), func(b base[SlotBuilder]) SlotBuilder {
+		return SlotBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b SlotBuilder) Children(children ...x.Elem) SlotBuilder {
+	return b.add(children...)
+}
+
+// Name sets the name attribute.
+func (b SlotBuilder) Name(value string) SlotBuilder {
+	return b.add(x.Att("name", value))
+}
+
+// CanvasBuilder builds a <canvas> element.
+type CanvasBuilder struct{ base[CanvasBuilder] }
+
+// Canvas starts a <canvas> (graphics canvas) element with optional children.
+func Canvas(children ...x.Elem) CanvasBuilder {
+	return CanvasBuilder{newBase(x.Canvas(children...), func(b base[CanvasBuilder]) CanvasBuilder {
+		return CanvasBuilder{b}
+	})}
+}
+
+// Children appends children to the element.
+func (b CanvasBuilder) Children(children ...x.Elem) CanvasBuilder {
+	return b.add(children...)
+}
+
+// Width sets the width attribute to an integer.
+func (b CanvasBuilder) Width(n int) CanvasBuilder {
+	return b.add(x.AttInt("width", n))
+}
+
+// Height sets the height attribute to an integer.
+func (b CanvasBuilder) Height(n int) CanvasBuilder {
+	return b.add(x.AttInt("height", n))
+}
+
+// ParamBuilder builds a <param> element.
+type ParamBuilder struct{ base[ParamBuilder] }
+
+// Param starts a <param> (object parameter) element. This is a self-closing tag, so it takes no children.
+//
+// Deprecated: <param> is obsolete in the HTML standard.
+func Param() ParamBuilder {
+	return ParamBuilder{newBase(x.Param(), func(b base[ParamBuilder]) ParamBuilder {
+		return ParamBuilder{b}
+	})}
+}
+
+// Name sets the name attribute.
+func (b ParamBuilder) Name(value string) ParamBuilder {
+	return b.add(x.Att("name", value))
+}
+
+// Value sets the value attribute.
+func (b ParamBuilder) Value(value string) ParamBuilder {
+	return b.add(x.Att("value", value))
+}
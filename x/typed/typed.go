@@ -0,0 +1,87 @@
+// Package typed builds elements with one builder type per element, whose
+// methods are the attributes the element accepts. An attribute that is not
+// valid for an element does not compile, and void elements take no
+// children:
+//
+//	typed.Input().Type(typed.InputEmail).Name("email").Required()
+//	typed.A(x.C("Docs")).Href("/docs").Target(typed.Blank)
+//
+// Builders are values: every method returns a new builder and leaves the
+// receiver unchanged, so a partly configured builder can be shared. Elem
+// returns the built x.Elem, which mixes freely with the rest of package x.
+// The builders are generated from package spec.
+package typed
+
+import (
+	"io"
+	"strings"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+// Builder is implemented by every element builder.
+type Builder interface {
+	Elem() x.Elem
+}
+
+// Elems returns the elements built by builders, for use as children.
+func Elems(builders ...Builder) []x.Elem {
+	elems := make([]x.Elem, len(builders))
+	for i, b := range builders {
+		elems[i] = b.Elem()
+	}
+	return elems
+}
+
+// base holds the element a builder of type B is building. It carries the
+// methods shared by every builder, such as the global attributes.
+type base[B any] struct {
+	e    x.Elem
+	wrap func(base[B]) B
+}
+
+func newBase[B any](e x.Elem, wrap func(base[B]) B) base[B] {
+	return base[B]{e: e, wrap: wrap}
+}
+
+// Elem returns the built element.
+func (b base[B]) Elem() x.Elem {
+	return b.e
+}
+
+// Render renders the built element to w.
+func (b base[B]) Render(w io.Writer) error {
+	return b.e.Render(w)
+}
+
+// add returns a builder with nodes appended to the element's children. The
+// children are copied so the receiver is left unchanged.
+func (b base[B]) add(nodes ...x.Elem) B {
+	children := make([]x.Elem, 0, len(b.e.Children)+len(nodes))
+	children = append(children, b.e.Children...)
+	b.e.Children = append(children, nodes...)
+	return b.wrap(b)
+}
+
+// Att sets any attribute, such as hx-post or one the spec does not list.
+func (b base[B]) Att(key, value string) B {
+	return b.add(x.Att(key, value))
+}
+
+// Data sets the custom data-key attribute.
+func (b base[B]) Data(key, value string) B {
+	return b.add(x.Data(key, value))
+}
+
+// Aria sets the aria-key attribute.
+func (b base[B]) Aria(key, value string) B {
+	return b.add(x.Aria(key, value))
+}
+
+func joinTokens[T ~string](values []T) string {
+	tokens := make([]string, len(values))
+	for i, v := range values {
+		tokens[i] = string(v)
+	}
+	return strings.Join(tokens, " ")
+}
@@ -0,0 +1,70 @@
+package typed
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/zulubit/xxhtml/x"
+)
+
+func TestBuilders(t *testing.T) {
+	field := Input().Name("q")
+	tests := []struct {
+		name     string
+		elem     Builder
+		expected string
+	}{
+		{
+			name:     "Typed input",
+			elem:     Input().Type(InputEmail).Name("email").Required(),
+			expected: `<input type="email" name="email" required />`,
+		},
+		{
+			name:     "Anchor with target and rel",
+			elem:     A(x.C("Docs")).Href("/docs").Target(Blank).Rel(RelNoopener, RelNoreferrer),
+			expected: `<a href="/docs" target="_blank" rel="noopener noreferrer">Docs</a>`,
+		},
+		{
+			name:     "Global attributes",
+			elem:     Div().ID("main").Class("a", "b").Dir(DirRTL).TabIndex(-1).Hidden(),
+			expected: `<div id="main" class="a b" dir="rtl" tabindex="-1" hidden></div>`,
+		},
+		{
+			name:     "Children and nested builders",
+			elem:     Ul(Li(x.C("one")).Elem()).Children(Elems(Li(x.C("two")), Li(x.C("three")))...),
+			expected: `<ul><li>one</li><li>two</li><li>three</li></ul>`,
+		},
+		{
+			name:     "Shared keyword types",
+			elem:     Form(Button(x.C("Go")).Type(ButtonSubmit).FormMethod(MethodPost).Elem()).Method(MethodGet),
+			expected: `<form method="get"><button type="submit" formmethod="post">Go</button></form>`,
+		},
+		{
+			name:     "Other values",
+			elem:     Td().ColSpan(2).Att("hx-get", "/cell").Data("id", "7").Aria("label", "Cell"),
+			expected: `<td colspan="2" hx-get="/cell" data-id="7" aria-label="Cell"></td>`,
+		},
+		{
+			name:     "Open keyword type",
+			elem:     Script().Type(ScriptType("text/plain")),
+			expected: `<script type="text/plain"></script>`,
+		},
+		{
+			name:     "Builders are values",
+			elem:     Div(field.Type(InputSearch).Elem(), field.Elem()),
+			expected: `<div><input name="q" type="search" /><input name="q" /></div>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.elem.Elem().Render(&buf); err != nil {
+				t.Fatalf("Render() returned an error: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, buf.String())
+			}
+		})
+	}
+}
@@ -4,8 +4,14 @@ import (
 	"fmt"
 	"html"
 	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
 )
 
+//go:generate go run ./internal/genhtml
+
 // NodeType represents the type of an HTML node.
 type NodeType int
 
@@ -15,6 +21,17 @@ const (
 	AttributeNode                  // Represents an HTML attribute
 	ContentNode                    // Represents text content (escaped)
 	RawContentNode                 // Represents unescaped (raw) HTML content
+	FragmentNode                   // Represents a group of nodes rendered without a wrapping tag
+	FuncNode                       // Represents output produced by a function at render time
+)
+
+// AttrKind tells how an attribute node renders.
+type AttrKind int
+
+const (
+	AttrValue   AttrKind = iota // Renders key="value", also when the value is empty; default value (0)
+	AttrBoolean                 // Renders the key alone, such as disabled
+	AttrOmitted                 // Renders nothing, as if the attribute were absent
 )
 
 // Elem represents an HTML element with attributes, text, and children.
@@ -23,17 +40,30 @@ type Elem struct {
 	Tag        string   // Tag name (for TagNode)
 	AttrKey    string   // Attribute key (for AttributeNode)
 	AttrVal    string   // Attribute value (for AttributeNode)
+	AttrKind   AttrKind // How the attribute renders (for AttributeNode)
 	Content    string   // Text content (for ContentNode or RawContentNode)
 	Children   []Elem   // Child nodes
 	SelfCloses bool     // Indicates if the element is self-closing
+
+	// Func produces the output of a FuncNode when the tree is rendered.
+	Func func(ctx *RenderContext) error
 }
 
 // Render writes the HTML representation of the element and its children to an io.Writer.
 func (e Elem) Render(w io.Writer) error {
-	return e.render(w)
+	return e.RenderWith(NewRenderContext(w))
+}
+
+// RenderWith renders the element using ctx, so that the state collected
+// during rendering, such as the islands used, can be read afterwards.
+func (e Elem) RenderWith(ctx *RenderContext) error {
+	if err := e.render(ctx); err != nil {
+		return err
+	}
+	return ctx.flush()
 }
 
-func (e Elem) render(w io.Writer) error {
+func (e Elem) render(w *RenderContext) error {
 	switch e.Type {
 	case EmptyNode:
 		// Do nothing for empty nodes.
@@ -44,22 +74,15 @@ func (e Elem) render(w io.Writer) error {
 			return err
 		}
 
-		// Render attributes: assume that attribute nodes come first.
-		firstNonAttrIndex := len(e.Children)
-		for i, child := range e.Children {
-			if child.Type == AttributeNode {
-				if err := child.render(w); err != nil {
-					return err
-				}
-			} else {
-				firstNonAttrIndex = i
-				break
-			}
+		// Render attributes. Attribute nodes nested in fragments belong to
+		// the enclosing tag.
+		if err := renderAttrs(w, e.Children); err != nil {
+			return err
 		}
 
 		// Handle self-closing tags: if the element is marked as self-closing
 		// and has no non-attribute children, output as self-closing.
-		if e.SelfCloses && firstNonAttrIndex == len(e.Children) {
+		if e.SelfCloses && !hasContent(e.Children) {
 			if _, err := w.Write([]byte(" />")); err != nil {
 				return err
 			}
@@ -72,14 +95,8 @@ func (e Elem) render(w io.Writer) error {
 		}
 
 		// Render non-attribute children.
-		for i := firstNonAttrIndex; i < len(e.Children); i++ {
-			child := e.Children[i]
-			// Render any child that is not an attribute.
-			if child.Type != AttributeNode {
-				if err := child.render(w); err != nil {
-					return err
-				}
-			}
+		if err := renderContent(w, e.Children); err != nil {
+			return err
 		}
 
 		// Write closing tag.
@@ -88,8 +105,11 @@ func (e Elem) render(w io.Writer) error {
 		}
 	case AttributeNode:
 		attrStr := " " + e.AttrKey
-		if e.AttrVal != "" {
-			attrStr += fmt.Sprintf(`="%s"`, html.EscapeString(e.AttrVal))
+		switch e.AttrKind {
+		case AttrOmitted:
+			return nil
+		case AttrValue:
+			attrStr += `="` + html.EscapeString(e.AttrVal) + `"`
 		}
 		if _, err := w.Write([]byte(attrStr)); err != nil {
 			return err
@@ -104,6 +124,14 @@ func (e Elem) render(w io.Writer) error {
 		if _, err := w.Write([]byte(e.Content)); err != nil {
 			return err
 		}
+	case FragmentNode:
+		// Render children in place; attributes are rendered by the enclosing tag.
+		return renderContent(w, e.Children)
+	case FuncNode:
+		if e.Func == nil {
+			return nil
+		}
+		return e.Func(w)
 	default:
 		return fmt.Errorf("unknown node type: %d", e.Type)
 	}
@@ -111,6 +139,83 @@ func (e Elem) render(w io.Writer) error {
 	return nil
 }
 
+// renderAttrs renders the attribute nodes among children, looking through
+// fragments, with repeated attributes merged by attrsOf.
+func renderAttrs(w *RenderContext, children []Elem) error {
+	for _, a := range attrsOf(children) {
+		if err := a.render(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attrMergers combine the values of an attribute that is set more than once
+// on an element into the single value that is rendered.
+var attrMergers = map[string]func(values []string) string{
+	"class": mergeClasses,
+	"style": mergeStyles,
+}
+
+// attrsOf returns the attributes among children that are not omitted,
+// looking through fragments. An attribute with a merger that appears more
+// than once is rendered once, at its first position, with the merged
+// value.
+func attrsOf(children []Elem) []Elem {
+	var attrs []Elem
+	var first map[string]int
+	var values map[string][]string
+	eachAttr(children, func(a Elem) bool {
+		if _, ok := attrMergers[a.AttrKey]; ok {
+			if first == nil {
+				first, values = make(map[string]int), make(map[string][]string)
+			}
+			values[a.AttrKey] = append(values[a.AttrKey], a.AttrVal)
+			if _, seen := first[a.AttrKey]; seen {
+				return true
+			}
+			first[a.AttrKey] = len(attrs)
+		}
+		attrs = append(attrs, a)
+		return true
+	})
+	for key, i := range first {
+		if len(values[key]) > 1 {
+			attrs[i] = Att(key, attrMergers[key](values[key]))
+		}
+	}
+	return attrs
+}
+
+// renderContent renders every non-attribute node among children.
+func renderContent(w *RenderContext, children []Elem) error {
+	for _, child := range children {
+		if child.Type == AttributeNode {
+			continue
+		}
+		if err := child.render(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasContent reports whether children contain anything besides attributes and empty nodes.
+func hasContent(children []Elem) bool {
+	for _, child := range children {
+		switch child.Type {
+		case AttributeNode, EmptyNode:
+		case FragmentNode:
+			if hasContent(child.Children) {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+	return false
+}
+
 // E initializes a new Elem with the specified tag name and optional children.
 func E(tag string, children ...Elem) Elem {
 	return Elem{
@@ -121,6 +226,8 @@ func E(tag string, children ...Elem) Elem {
 }
 
 // Att creates an Elem representing an HTML attribute with a key-value pair.
+// An empty value renders as key="", as needed for alt="" on decorative
+// images; use AttBool for boolean attributes.
 func Att(key, value string) Elem {
 	return Elem{
 		Type:    AttributeNode,
@@ -129,12 +236,69 @@ func Att(key, value string) Elem {
 	}
 }
 
+// AttIf creates an attribute that is omitted when cond is false.
+func AttIf(cond bool, key, value string) Elem {
+	a := Att(key, value)
+	if !cond {
+		a.AttrKind = AttrOmitted
+	}
+	return a
+}
+
+// AttBool creates a boolean attribute, such as disabled, that renders as
+// its key alone when present is true and is omitted otherwise.
+func AttBool(key string, present bool) Elem {
+	a := Att(key, "")
+	a.AttrKind = AttrBoolean
+	if !present {
+		a.AttrKind = AttrOmitted
+	}
+	return a
+}
+
+// AttInt creates an attribute with an integer value, such as colspan="2".
+func AttInt(key string, value int) Elem {
+	return Att(key, strconv.Itoa(value))
+}
+
+// AttFloat creates an attribute with a number value in the shortest form
+// that represents it exactly, such as "0.5" or "3". NaN and infinities are
+// not valid HTML numbers, so the attribute is omitted for them.
+func AttFloat(key string, value float64) Elem {
+	return AttIf(!math.IsNaN(value) && !math.IsInf(value, 0), key, strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// AttrTimeFormat is the layout used by AttTime: a valid global date and
+// time string with up to millisecond precision, such as
+// "2024-05-01T09:30:00Z".
+const AttrTimeFormat = "2006-01-02T15:04:05.999Z07:00"
+
+// AttTime creates an attribute with a time value formatted with
+// AttrTimeFormat, such as for the datetime attribute of time. The zero time
+// is omitted.
+func AttTime(key string, t time.Time) Elem {
+	return AttIf(!t.IsZero(), key, t.Format(AttrTimeFormat))
+}
+
+// AttStringer creates an attribute with the value of v.String(). A nil v,
+// or a nil pointer inside it, is omitted.
+func AttStringer(key string, v fmt.Stringer) Elem {
+	if v == nil {
+		return AttIf(false, key, "")
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return AttIf(false, key, "")
+	}
+	return Att(key, v.String())
+}
+
 // C creates an Elem with escaped HTML content or plain text.
+// The content is escaped when the element is rendered.
 func C(value interface{}) Elem {
 	content := fmt.Sprintf("%v", value)
 	return Elem{
 		Type:    ContentNode,
-		Content: html.EscapeString(content),
+		Content: content,
 	}
 }
 
@@ -147,6 +311,24 @@ func CR(value interface{}) Elem {
 	}
 }
 
+// Fragment groups elements without a wrapping tag. Attributes inside a
+// fragment are applied to the enclosing element.
+func Fragment(children ...Elem) Elem {
+	return Elem{
+		Type:     FragmentNode,
+		Children: children,
+	}
+}
+
+// RenderFunc creates an Elem whose output is written by fn when the tree is
+// rendered. fn may write to ctx directly or render other elements with it.
+func RenderFunc(fn func(ctx *RenderContext) error) Elem {
+	return Elem{
+		Type: FuncNode,
+		Func: fn,
+	}
+}
+
 // SelfClose marks an element as self-closing.
 func (e Elem) SelfClose() Elem {
 	e.SelfCloses = true
@@ -1,10 +1,812 @@
+// Code generated by internal/genhtml from package spec. DO NOT EDIT.
+
 package x
 
-// Class creates an Elem representing a CSS class.
-func Class(classes string) Elem {
-	return Elem{
-		Type:    AttributeNode,
-		AttrKey: "class",
-		AttrVal: classes,
+import "strings"
+
+// InputType is a value of the type attribute of input.
+type InputType string
+
+const (
+	InputButton        InputType = "button"
+	InputCheckbox      InputType = "checkbox"
+	InputColor         InputType = "color"
+	InputDate          InputType = "date"
+	InputDatetimeLocal InputType = "datetime-local"
+	InputEmail         InputType = "email"
+	InputFile          InputType = "file"
+	InputHidden        InputType = "hidden"
+	InputImage         InputType = "image"
+	InputMonth         InputType = "month"
+	InputNumber        InputType = "number"
+	InputPassword      InputType = "password"
+	InputRadio         InputType = "radio"
+	InputRange         InputType = "range"
+	InputReset         InputType = "reset"
+	InputSearch        InputType = "search"
+	InputSubmit        InputType = "submit"
+	InputTel           InputType = "tel"
+	InputText          InputType = "text"
+	InputTime          InputType = "time"
+	InputURL           InputType = "url"
+	InputWeek          InputType = "week"
+)
+
+// LinkType is a value of the rel attribute.
+type LinkType string
+
+const (
+	RelAlternate      LinkType = "alternate"
+	RelAuthor         LinkType = "author"
+	RelBookmark       LinkType = "bookmark"
+	RelCanonical      LinkType = "canonical"
+	RelDNSPrefetch    LinkType = "dns-prefetch"
+	RelExpect         LinkType = "expect"
+	RelExternal       LinkType = "external"
+	RelHelp           LinkType = "help"
+	RelIcon           LinkType = "icon"
+	RelLicense        LinkType = "license"
+	RelManifest       LinkType = "manifest"
+	RelMe             LinkType = "me"
+	RelModulepreload  LinkType = "modulepreload"
+	RelNext           LinkType = "next"
+	RelNofollow       LinkType = "nofollow"
+	RelNoopener       LinkType = "noopener"
+	RelNoreferrer     LinkType = "noreferrer"
+	RelOpener         LinkType = "opener"
+	RelPingback       LinkType = "pingback"
+	RelPreconnect     LinkType = "preconnect"
+	RelPrefetch       LinkType = "prefetch"
+	RelPreload        LinkType = "preload"
+	RelPrev           LinkType = "prev"
+	RelPrivacyPolicy  LinkType = "privacy-policy"
+	RelSearch         LinkType = "search"
+	RelStylesheet     LinkType = "stylesheet"
+	RelTag            LinkType = "tag"
+	RelTermsOfService LinkType = "terms-of-service"
+)
+
+// TargetName is a value of the target attribute: one of the keywords below or the name of a window or frame.
+type TargetName string
+
+const (
+	TargetBlank  TargetName = "_blank"
+	TargetSelf   TargetName = "_self"
+	TargetParent TargetName = "_parent"
+	TargetTop    TargetName = "_top"
+)
+
+// AbbrAttr sets the abbr attribute.
+func AbbrAttr(value string) Elem {
+	return Att("abbr", value)
+}
+
+// Accept sets the accept attribute.
+func Accept(value string) Elem {
+	return Att("accept", value)
+}
+
+// AcceptCharset sets the accept-charset attribute.
+func AcceptCharset(value string) Elem {
+	return Att("accept-charset", value)
+}
+
+// AccessKey sets the accesskey attribute.
+func AccessKey(value string) Elem {
+	return Att("accesskey", value)
+}
+
+// Action sets the action attribute.
+func Action(value string) Elem {
+	return Att("action", value)
+}
+
+// Allow sets the allow attribute.
+func Allow(value string) Elem {
+	return Att("allow", value)
+}
+
+// AllowFullscreen sets the boolean allowfullscreen attribute.
+func AllowFullscreen() Elem {
+	return AttBool("allowfullscreen", true)
+}
+
+// Alpha sets the boolean alpha attribute.
+func Alpha() Elem {
+	return AttBool("alpha", true)
+}
+
+// Alt sets the alt attribute.
+func Alt(value string) Elem {
+	return Att("alt", value)
+}
+
+// As sets the as attribute to fetch, audio, document, embed, font, image, json, object, script, style, track, video or worker.
+func As(value string) Elem {
+	return Att("as", value)
+}
+
+// Async sets the boolean async attribute.
+func Async() Elem {
+	return AttBool("async", true)
+}
+
+// AutoCapitalize sets the autocapitalize attribute to off, none, on, sentences, words or characters.
+func AutoCapitalize(value string) Elem {
+	return Att("autocapitalize", value)
+}
+
+// AutoComplete sets the autocomplete attribute.
+func AutoComplete(value string) Elem {
+	return Att("autocomplete", value)
+}
+
+// AutoCorrect sets the autocorrect attribute to on or off.
+func AutoCorrect(value string) Elem {
+	return Att("autocorrect", value)
+}
+
+// AutoFocus sets the boolean autofocus attribute.
+func AutoFocus() Elem {
+	return AttBool("autofocus", true)
+}
+
+// AutoPlay sets the boolean autoplay attribute.
+func AutoPlay() Elem {
+	return AttBool("autoplay", true)
+}
+
+// Blocking sets the blocking attribute.
+func Blocking(value string) Elem {
+	return Att("blocking", value)
+}
+
+// Charset sets the charset attribute to utf-8.
+func Charset(value string) Elem {
+	return Att("charset", value)
+}
+
+// Checked sets the boolean checked attribute.
+func Checked() Elem {
+	return AttBool("checked", true)
+}
+
+// CiteAttr sets the cite attribute.
+func CiteAttr(value string) Elem {
+	return Att("cite", value)
+}
+
+// Class sets the class attribute.
+func Class(value string) Elem {
+	return Att("class", value)
+}
+
+// ClosedBy sets the closedby attribute to any, closerequest or none.
+func ClosedBy(value string) Elem {
+	return Att("closedby", value)
+}
+
+// Color sets the color attribute.
+func Color(value string) Elem {
+	return Att("color", value)
+}
+
+// ColorSpace sets the colorspace attribute to limited-srgb or display-p3.
+func ColorSpace(value string) Elem {
+	return Att("colorspace", value)
+}
+
+// Cols sets the cols attribute to an integer.
+func Cols(n int) Elem {
+	return AttInt("cols", n)
+}
+
+// ColSpan sets the colspan attribute to an integer.
+func ColSpan(n int) Elem {
+	return AttInt("colspan", n)
+}
+
+// Command sets the command attribute.
+func Command(value string) Elem {
+	return Att("command", value)
+}
+
+// CommandFor sets the commandfor attribute.
+func CommandFor(value string) Elem {
+	return Att("commandfor", value)
+}
+
+// Content sets the content attribute.
+func Content(value string) Elem {
+	return Att("content", value)
+}
+
+// ContentEditable sets the contenteditable attribute.
+func ContentEditable(value string) Elem {
+	return Att("contenteditable", value)
+}
+
+// Controls sets the boolean controls attribute.
+func Controls() Elem {
+	return AttBool("controls", true)
+}
+
+// Coords sets the coords attribute.
+func Coords(value string) Elem {
+	return Att("coords", value)
+}
+
+// CrossOrigin sets the crossorigin attribute to anonymous or use-credentials.
+func CrossOrigin(value string) Elem {
+	return Att("crossorigin", value)
+}
+
+// DataAttr sets the data attribute.
+func DataAttr(value string) Elem {
+	return Att("data", value)
+}
+
+// DateTime sets the datetime attribute.
+func DateTime(value string) Elem {
+	return Att("datetime", value)
+}
+
+// Decoding sets the decoding attribute to sync, async or auto.
+func Decoding(value string) Elem {
+	return Att("decoding", value)
+}
+
+// Default sets the boolean default attribute.
+func Default() Elem {
+	return AttBool("default", true)
+}
+
+// Defer sets the boolean defer attribute.
+func Defer() Elem {
+	return AttBool("defer", true)
+}
+
+// Dir sets the dir attribute to ltr, rtl or auto.
+func Dir(value string) Elem {
+	return Att("dir", value)
+}
+
+// DirName sets the dirname attribute.
+func DirName(value string) Elem {
+	return Att("dirname", value)
+}
+
+// Disabled sets the boolean disabled attribute.
+func Disabled() Elem {
+	return AttBool("disabled", true)
+}
+
+// Download sets the download attribute.
+func Download(value string) Elem {
+	return Att("download", value)
+}
+
+// Draggable sets the draggable attribute to true or false.
+func Draggable(value string) Elem {
+	return Att("draggable", value)
+}
+
+// EncType sets the enctype attribute to application/x-www-form-urlencoded, multipart/form-data or text/plain.
+func EncType(value string) Elem {
+	return Att("enctype", value)
+}
+
+// EnterKeyHint sets the enterkeyhint attribute to enter, done, go, next, previous, search or send.
+func EnterKeyHint(value string) Elem {
+	return Att("enterkeyhint", value)
+}
+
+// FetchPriority sets the fetchpriority attribute to high, low or auto.
+func FetchPriority(value string) Elem {
+	return Att("fetchpriority", value)
+}
+
+// For sets the for attribute.
+func For(value string) Elem {
+	return Att("for", value)
+}
+
+// FormAttr sets the form attribute.
+func FormAttr(value string) Elem {
+	return Att("form", value)
+}
+
+// FormAction sets the formaction attribute.
+func FormAction(value string) Elem {
+	return Att("formaction", value)
+}
+
+// FormEncType sets the formenctype attribute to application/x-www-form-urlencoded, multipart/form-data or text/plain.
+func FormEncType(value string) Elem {
+	return Att("formenctype", value)
+}
+
+// FormMethod sets the formmethod attribute to get, post or dialog.
+func FormMethod(value string) Elem {
+	return Att("formmethod", value)
+}
+
+// FormNoValidate sets the boolean formnovalidate attribute.
+func FormNoValidate() Elem {
+	return AttBool("formnovalidate", true)
+}
+
+// FormTarget sets the formtarget attribute.
+func FormTarget(value string) Elem {
+	return Att("formtarget", value)
+}
+
+// Headers sets the headers attribute.
+func Headers(value string) Elem {
+	return Att("headers", value)
+}
+
+// Height sets the height attribute to an integer.
+func Height(n int) Elem {
+	return AttInt("height", n)
+}
+
+// Hidden sets the boolean hidden attribute.
+func Hidden() Elem {
+	return AttBool("hidden", true)
+}
+
+// High sets the high attribute to a number.
+func High(n float64) Elem {
+	return AttFloat("high", n)
+}
+
+// Href sets the href attribute.
+func Href(value string) Elem {
+	return Att("href", value)
+}
+
+// HrefLang sets the hreflang attribute.
+func HrefLang(value string) Elem {
+	return Att("hreflang", value)
+}
+
+// HTTPEquiv sets the http-equiv attribute to content-language, content-type, default-style, refresh, set-cookie, x-ua-compatible or content-security-policy.
+func HTTPEquiv(value string) Elem {
+	return Att("http-equiv", value)
+}
+
+// ID sets the id attribute.
+func ID(value string) Elem {
+	return Att("id", value)
+}
+
+// ImageSizes sets the imagesizes attribute.
+func ImageSizes(value string) Elem {
+	return Att("imagesizes", value)
+}
+
+// ImageSrcSet sets the imagesrcset attribute.
+func ImageSrcSet(value string) Elem {
+	return Att("imagesrcset", value)
+}
+
+// Inert sets the boolean inert attribute.
+func Inert() Elem {
+	return AttBool("inert", true)
+}
+
+// InputMode sets the inputmode attribute to none, text, decimal, numeric, tel, search, email or url.
+func InputMode(value string) Elem {
+	return Att("inputmode", value)
+}
+
+// Integrity sets the integrity attribute.
+func Integrity(value string) Elem {
+	return Att("integrity", value)
+}
+
+// Is sets the is attribute.
+func Is(value string) Elem {
+	return Att("is", value)
+}
+
+// IsMap sets the boolean ismap attribute.
+func IsMap() Elem {
+	return AttBool("ismap", true)
+}
+
+// ItemID sets the itemid attribute.
+func ItemID(value string) Elem {
+	return Att("itemid", value)
+}
+
+// ItemProp sets the itemprop attribute.
+func ItemProp(value string) Elem {
+	return Att("itemprop", value)
+}
+
+// ItemRef sets the itemref attribute.
+func ItemRef(value string) Elem {
+	return Att("itemref", value)
+}
+
+// ItemScope sets the boolean itemscope attribute.
+func ItemScope() Elem {
+	return AttBool("itemscope", true)
+}
+
+// ItemType sets the itemtype attribute.
+func ItemType(value string) Elem {
+	return Att("itemtype", value)
+}
+
+// Kind sets the kind attribute to subtitles, captions, descriptions, chapters or metadata.
+func Kind(value string) Elem {
+	return Att("kind", value)
+}
+
+// LabelAttr sets the label attribute.
+func LabelAttr(value string) Elem {
+	return Att("label", value)
+}
+
+// Lang sets the lang attribute.
+func Lang(value string) Elem {
+	return Att("lang", value)
+}
+
+// List sets the list attribute.
+func List(value string) Elem {
+	return Att("list", value)
+}
+
+// Loading sets the loading attribute to lazy or eager.
+func Loading(value string) Elem {
+	return Att("loading", value)
+}
+
+// Loop sets the boolean loop attribute.
+func Loop() Elem {
+	return AttBool("loop", true)
+}
+
+// Low sets the low attribute to a number.
+func Low(n float64) Elem {
+	return AttFloat("low", n)
+}
+
+// Max sets the max attribute.
+func Max(value string) Elem {
+	return Att("max", value)
+}
+
+// MaxLength sets the maxlength attribute to an integer.
+func MaxLength(n int) Elem {
+	return AttInt("maxlength", n)
+}
+
+// Media sets the media attribute.
+func Media(value string) Elem {
+	return Att("media", value)
+}
+
+// Method sets the method attribute to get, post or dialog.
+func Method(value string) Elem {
+	return Att("method", value)
+}
+
+// Min sets the min attribute.
+func Min(value string) Elem {
+	return Att("min", value)
+}
+
+// MinLength sets the minlength attribute to an integer.
+func MinLength(n int) Elem {
+	return AttInt("minlength", n)
+}
+
+// Multiple sets the boolean multiple attribute.
+func Multiple() Elem {
+	return AttBool("multiple", true)
+}
+
+// Muted sets the boolean muted attribute.
+func Muted() Elem {
+	return AttBool("muted", true)
+}
+
+// Name sets the name attribute.
+func Name(value string) Elem {
+	return Att("name", value)
+}
+
+// NoModule sets the boolean nomodule attribute.
+func NoModule() Elem {
+	return AttBool("nomodule", true)
+}
+
+// Nonce sets the nonce attribute.
+func Nonce(value string) Elem {
+	return Att("nonce", value)
+}
+
+// NoValidate sets the boolean novalidate attribute.
+func NoValidate() Elem {
+	return AttBool("novalidate", true)
+}
+
+// Open sets the boolean open attribute.
+func Open() Elem {
+	return AttBool("open", true)
+}
+
+// Optimum sets the optimum attribute to a number.
+func Optimum(n float64) Elem {
+	return AttFloat("optimum", n)
+}
+
+// Pattern sets the pattern attribute.
+func Pattern(value string) Elem {
+	return Att("pattern", value)
+}
+
+// Ping sets the ping attribute.
+func Ping(value string) Elem {
+	return Att("ping", value)
+}
+
+// Placeholder sets the placeholder attribute.
+func Placeholder(value string) Elem {
+	return Att("placeholder", value)
+}
+
+// PlaysInline sets the boolean playsinline attribute.
+func PlaysInline() Elem {
+	return AttBool("playsinline", true)
+}
+
+// Popover sets the popover attribute.
+func Popover(value string) Elem {
+	return Att("popover", value)
+}
+
+// PopoverTarget sets the popovertarget attribute.
+func PopoverTarget(value string) Elem {
+	return Att("popovertarget", value)
+}
+
+// PopoverTargetAction sets the popovertargetaction attribute to toggle, show or hide.
+func PopoverTargetAction(value string) Elem {
+	return Att("popovertargetaction", value)
+}
+
+// Poster sets the poster attribute.
+func Poster(value string) Elem {
+	return Att("poster", value)
+}
+
+// Preload sets the preload attribute to none, metadata or auto.
+func Preload(value string) Elem {
+	return Att("preload", value)
+}
+
+// ReadOnly sets the boolean readonly attribute.
+func ReadOnly() Elem {
+	return AttBool("readonly", true)
+}
+
+// ReferrerPolicy sets the referrerpolicy attribute to no-referrer, no-referrer-when-downgrade, same-origin, origin, strict-origin, origin-when-cross-origin, strict-origin-when-cross-origin or unsafe-url.
+func ReferrerPolicy(value string) Elem {
+	return Att("referrerpolicy", value)
+}
+
+// Rel sets the rel attribute, to the given link types, such as Rel(RelNoopener, RelNoreferrer).
+func Rel(values ...LinkType) Elem {
+	return Att("rel", joinTokens(values))
+}
+
+// Required sets the boolean required attribute.
+func Required() Elem {
+	return AttBool("required", true)
+}
+
+// Reversed sets the boolean reversed attribute.
+func Reversed() Elem {
+	return AttBool("reversed", true)
+}
+
+// Role sets the role attribute.
+func Role(value string) Elem {
+	return Att("role", value)
+}
+
+// Rows sets the rows attribute to an integer.
+func Rows(n int) Elem {
+	return AttInt("rows", n)
+}
+
+// RowSpan sets the rowspan attribute to an integer.
+func RowSpan(n int) Elem {
+	return AttInt("rowspan", n)
+}
+
+// Sandbox sets the sandbox attribute.
+func Sandbox(value string) Elem {
+	return Att("sandbox", value)
+}
+
+// Scope sets the scope attribute to row, col, rowgroup or colgroup.
+func Scope(value string) Elem {
+	return Att("scope", value)
+}
+
+// Selected sets the boolean selected attribute.
+func Selected() Elem {
+	return AttBool("selected", true)
+}
+
+// ShadowRootClonable sets the boolean shadowrootclonable attribute.
+func ShadowRootClonable() Elem {
+	return AttBool("shadowrootclonable", true)
+}
+
+// ShadowRootDelegatesFocus sets the boolean shadowrootdelegatesfocus attribute.
+func ShadowRootDelegatesFocus() Elem {
+	return AttBool("shadowrootdelegatesfocus", true)
+}
+
+// ShadowRootMode sets the shadowrootmode attribute to open or closed.
+func ShadowRootMode(value string) Elem {
+	return Att("shadowrootmode", value)
+}
+
+// ShadowRootSerializable sets the boolean shadowrootserializable attribute.
+func ShadowRootSerializable() Elem {
+	return AttBool("shadowrootserializable", true)
+}
+
+// Shape sets the shape attribute to circle, default, poly or rect.
+func Shape(value string) Elem {
+	return Att("shape", value)
+}
+
+// Size sets the size attribute to an integer.
+func Size(n int) Elem {
+	return AttInt("size", n)
+}
+
+// Sizes sets the sizes attribute.
+func Sizes(value string) Elem {
+	return Att("sizes", value)
+}
+
+// SlotAttr sets the slot attribute.
+func SlotAttr(value string) Elem {
+	return Att("slot", value)
+}
+
+// SpanAttr sets the span attribute to an integer.
+func SpanAttr(n int) Elem {
+	return AttInt("span", n)
+}
+
+// SpellCheck sets the spellcheck attribute.
+func SpellCheck(value string) Elem {
+	return Att("spellcheck", value)
+}
+
+// Src sets the src attribute.
+func Src(value string) Elem {
+	return Att("src", value)
+}
+
+// SrcDoc sets the srcdoc attribute.
+func SrcDoc(value string) Elem {
+	return Att("srcdoc", value)
+}
+
+// SrcLang sets the srclang attribute.
+func SrcLang(value string) Elem {
+	return Att("srclang", value)
+}
+
+// SrcSet sets the srcset attribute.
+func SrcSet(value string) Elem {
+	return Att("srcset", value)
+}
+
+// Start sets the start attribute to an integer.
+func Start(n int) Elem {
+	return AttInt("start", n)
+}
+
+// Step sets the step attribute.
+func Step(value string) Elem {
+	return Att("step", value)
+}
+
+// StyleAttr sets the style attribute.
+func StyleAttr(value string) Elem {
+	return Att("style", value)
+}
+
+// TabIndex sets the tabindex attribute to an integer.
+func TabIndex(n int) Elem {
+	return AttInt("tabindex", n)
+}
+
+// Target sets the target attribute, such as Target(TargetBlank), or Target(TargetName("preview")) for a frame.
+func Target(value TargetName) Elem {
+	return Att("target", string(value))
+}
+
+// TitleAttr sets the title attribute.
+func TitleAttr(value string) Elem {
+	return Att("title", value)
+}
+
+// Translate sets the translate attribute to yes or no.
+func Translate(value string) Elem {
+	return Att("translate", value)
+}
+
+// Type sets the type attribute, such as Type("module") on script. Use InputTypeAttr for input.
+func Type(value string) Elem {
+	return Att("type", value)
+}
+
+// InputTypeAttr sets the type attribute to one of the InputType constants.
+func InputTypeAttr(value InputType) Elem {
+	return Att("type", string(value))
+}
+
+// UseMap sets the usemap attribute.
+func UseMap(value string) Elem {
+	return Att("usemap", value)
+}
+
+// Value sets the value attribute.
+func Value(value string) Elem {
+	return Att("value", value)
+}
+
+// Width sets the width attribute to an integer.
+func Width(n int) Elem {
+	return AttInt("width", n)
+}
+
+// Wrap sets the wrap attribute to soft or hard.
+func Wrap(value string) Elem {
+	return Att("wrap", value)
+}
+
+// WritingSuggestions sets the writingsuggestions attribute to true or false.
+func WritingSuggestions(value string) Elem {
+	return Att("writingsuggestions", value)
+}
+
+// XMLNS sets the xmlns attribute.
+func XMLNS(value string) Elem {
+	return Att("xmlns", value)
+}
+
+// Data sets the custom data-key attribute, such as Data("id", "42") for
+// data-id="42".
+func Data(key, value string) Elem {
+	return Att("data-"+key, value)
+}
+
+// Aria sets the aria-key attribute, such as Aria("expanded", "true") for
+// aria-expanded="true".
+func Aria(key, value string) Elem {
+	return Att("aria-"+key, value)
+}
+
+func joinTokens[T ~string](values []T) string {
+	tokens := make([]string, len(values))
+	for i, v := range values {
+		tokens[i] = string(v)
 	}
+	return strings.Join(tokens, " ")
 }
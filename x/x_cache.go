@@ -0,0 +1,425 @@
+package x
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FragmentStore holds rendered fragments for Cache. Implementations must be
+// safe for concurrent use.
+type FragmentStore interface {
+	// Get returns the fragment stored under key, unless it is missing or expired.
+	Get(key string) ([]byte, bool, error)
+	// Set stores a fragment for ttl, or indefinitely when ttl is zero.
+	Set(key string, value []byte, ttl time.Duration, tags []string) error
+	// Delete removes the fragment stored under key.
+	Delete(key string) error
+	// InvalidateTag removes every fragment stored with tag.
+	InvalidateTag(tag string) error
+}
+
+// DefaultFragmentStore is the store used by Cache, unless the render
+// context names another. It holds up to 1000 fragments in memory.
+var DefaultFragmentStore FragmentStore = NewMemoryFragmentStore(1000)
+
+// fragmentStoreKey is the RenderContext key holding the store set with
+// SetFragmentStore.
+type fragmentStoreKey struct{}
+
+// SetFragmentStore makes Cache keep the fragments rendered with ctx in
+// store rather than in DefaultFragmentStore.
+func SetFragmentStore(ctx *RenderContext, store FragmentStore) {
+	ctx.SetValue(fragmentStoreKey{}, store)
+}
+
+// Cache renders the element built by fn once and reuses the output for ttl,
+// or until a fragment with one of tags is invalidated. On a hit fn is not
+// called, so the subtree is never built. Keys must identify everything the
+// fragment depends on, such as the record and the language shown.
+// Fragments are kept in the store set with SetFragmentStore, or else in
+// DefaultFragmentStore.
+//
+// State the fragment records in the render context with
+// RenderContext.Effect, such as the islands and scoped styles used, is
+// replayed on every hit. Effects are held in memory for each store and key,
+// with a digest of the fragment they were recorded with, and replayed only
+// while the store holds that fragment. A fragment this process has not
+// rendered, such as one read from a DirFragmentStore after a restart or
+// written by another process, is rendered again once. Rendering fails when the
+// fragment sets a context value outside an effect. Store failures are
+// logged and the fragment is rendered afresh.
+func Cache(key string, ttl time.Duration, fn func() Elem, tags ...string) Elem {
+	return CacheIn(nil, key, ttl, fn, tags...)
+}
+
+// CacheIn is like Cache but keeps the fragment in store. A nil store means
+// the store Cache would use.
+func CacheIn(store FragmentStore, key string, ttl time.Duration, fn func() Elem, tags ...string) Elem {
+	return RenderFunc(func(ctx *RenderContext) error {
+		store := store
+		if store == nil {
+			store, _ = ctx.Value(fragmentStoreKey{}).(FragmentStore)
+		}
+		if store == nil {
+			store = DefaultFragmentStore
+		}
+		data, ok, err := store.Get(key)
+		if err != nil {
+			log.Printf("x: reading fragment %s: %v", key, err)
+		}
+		ref := fragmentRef{store: store, key: key}
+		if ok {
+			if effects, known := fragmentEffects.get(ref, data); known {
+				for _, effect := range effects {
+					ctx.Effect(effect)
+				}
+				_, err := ctx.Write(data)
+				return err
+			}
+		}
+
+		effects, err := ctx.recordEffects(func() (err error) {
+			data, err = ctx.capture(fn())
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("fragment %q: %w", key, err)
+		}
+		fragmentEffects.set(ref, data, effects)
+		if err := store.Set(key, data, ttl, tags); err != nil {
+			log.Printf("x: storing fragment %s: %v", key, err)
+		}
+		_, err = ctx.Write(data)
+		return err
+	})
+}
+
+// maxFragmentEffects bounds the number of fragments whose effects are held.
+// When it is reached they are all dropped, and fragments are rendered again
+// to record them.
+const maxFragmentEffects = 10000
+
+// fragmentEffects holds the effects recorded for each fragment, which
+// stores cannot hold since they are functions.
+var fragmentEffects = effectRegistry{m: make(map[fragmentRef]recordedEffects)}
+
+// fragmentRef identifies a fragment by the store holding it and its key.
+type fragmentRef struct {
+	store FragmentStore
+	key   string
+}
+
+// comparable reports whether ref can be a map key. Stores of other types,
+// such as structs holding a slice, have their fragments rendered on every
+// hit instead.
+func (ref fragmentRef) comparable() bool {
+	return reflect.TypeOf(ref.store).Comparable()
+}
+
+// recordedEffects are the effects of a fragment and the digest of the
+// output they were recorded with.
+type recordedEffects struct {
+	sum     [sha256.Size]byte
+	effects []func(ctx *RenderContext)
+}
+
+type effectRegistry struct {
+	mu sync.Mutex
+	m  map[fragmentRef]recordedEffects
+}
+
+// get returns the effects recorded for ref, if they were recorded with data.
+func (r *effectRegistry) get(ref fragmentRef, data []byte) ([]func(ctx *RenderContext), bool) {
+	if !ref.comparable() {
+		return nil, false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rec, ok := r.m[ref]
+	if !ok || rec.sum != sha256.Sum256(data) {
+		return nil, false
+	}
+	return rec.effects, true
+}
+
+func (r *effectRegistry) set(ref fragmentRef, data []byte, effects []func(ctx *RenderContext)) {
+	if !ref.comparable() {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.m[ref]; !ok && len(r.m) >= maxFragmentEffects {
+		r.m = make(map[fragmentRef]recordedEffects)
+	}
+	r.m[ref] = recordedEffects{sum: sha256.Sum256(data), effects: effects}
+}
+
+// InvalidateTag removes every fragment stored with tag from DefaultFragmentStore.
+func InvalidateTag(tag string) error {
+	return DefaultFragmentStore.InvalidateTag(tag)
+}
+
+// fragmentEntry is the metadata of a stored fragment.
+type fragmentEntry struct {
+	Key     string    `json:"key"`
+	Expires time.Time `json:"expires,omitempty"`
+	Tags    []string  `json:"tags,omitempty"`
+}
+
+func (e fragmentEntry) expired(now time.Time) bool {
+	return !e.Expires.IsZero() && !now.Before(e.Expires)
+}
+
+func newFragmentEntry(key string, ttl time.Duration, tags []string) fragmentEntry {
+	e := fragmentEntry{Key: key, Tags: append([]string(nil), tags...)}
+	if ttl > 0 {
+		e.Expires = time.Now().Add(ttl)
+	}
+	return e
+}
+
+// MemoryFragmentStore keeps fragments in memory, evicting the least
+// recently used one when full.
+type MemoryFragmentStore struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // of *memoryFragment, most recently used first
+	entries  map[string]*list.Element
+	tags     map[string]map[string]bool // tag to keys
+}
+
+type memoryFragment struct {
+	fragmentEntry
+	value []byte
+}
+
+// NewMemoryFragmentStore returns a store holding up to capacity fragments.
+// A capacity of zero or less means no limit.
+func NewMemoryFragmentStore(capacity int) *MemoryFragmentStore {
+	return &MemoryFragmentStore{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+		tags:     make(map[string]map[string]bool),
+	}
+}
+
+// Get implements FragmentStore.
+func (s *MemoryFragmentStore) Get(key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	el, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	f := el.Value.(*memoryFragment)
+	if f.expired(time.Now()) {
+		s.remove(key)
+		return nil, false, nil
+	}
+	s.order.MoveToFront(el)
+	return f.value, true, nil
+}
+
+// Set implements FragmentStore.
+func (s *MemoryFragmentStore) Set(key string, value []byte, ttl time.Duration, tags []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.remove(key)
+	f := &memoryFragment{fragmentEntry: newFragmentEntry(key, ttl, tags), value: value}
+	s.entries[key] = s.order.PushFront(f)
+	for _, tag := range f.Tags {
+		if s.tags[tag] == nil {
+			s.tags[tag] = make(map[string]bool)
+		}
+		s.tags[tag][key] = true
+	}
+	for s.capacity > 0 && s.order.Len() > s.capacity {
+		s.remove(s.order.Back().Value.(*memoryFragment).Key)
+	}
+	return nil
+}
+
+// Delete implements FragmentStore.
+func (s *MemoryFragmentStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.remove(key)
+	return nil
+}
+
+// InvalidateTag implements FragmentStore.
+func (s *MemoryFragmentStore) InvalidateTag(tag string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.tags[tag] {
+		s.remove(key)
+	}
+	return nil
+}
+
+// Len returns the number of fragments held, including expired ones not yet
+// evicted.
+func (s *MemoryFragmentStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.order.Len()
+}
+
+// remove deletes key and its tag references. The caller must hold s.mu.
+func (s *MemoryFragmentStore) remove(key string) {
+	el, ok := s.entries[key]
+	if !ok {
+		return
+	}
+	f := el.Value.(*memoryFragment)
+	for _, tag := range f.Tags {
+		delete(s.tags[tag], key)
+		if len(s.tags[tag]) == 0 {
+			delete(s.tags, tag)
+		}
+	}
+	s.order.Remove(el)
+	delete(s.entries, key)
+}
+
+// DirFragmentStore keeps fragments as files in a directory, so they can be
+// shared between processes and survive restarts. Each file starts with a
+// JSON line holding the key, expiry and tags, followed by the fragment.
+type DirFragmentStore struct {
+	Dir string
+}
+
+// NewDirFragmentStore returns a store writing to dir, which is created when
+// the first fragment is stored.
+func NewDirFragmentStore(dir string) *DirFragmentStore {
+	return &DirFragmentStore{Dir: dir}
+}
+
+func (s *DirFragmentStore) file(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.Dir, hex.EncodeToString(sum[:16])+".frag")
+}
+
+// Get implements FragmentStore.
+func (s *DirFragmentStore) Get(key string) ([]byte, bool, error) {
+	data, err := os.ReadFile(s.file(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	header, value, ok := bytes.Cut(data, []byte("\n"))
+	var entry fragmentEntry
+	if !ok || json.Unmarshal(header, &entry) != nil || entry.Key != key {
+		return nil, false, errors.New("x: malformed fragment file " + s.file(key))
+	}
+	if entry.expired(time.Now()) {
+		return nil, false, s.Delete(key)
+	}
+	return value, true, nil
+}
+
+// Set implements FragmentStore. The fragment is written to a temporary file
+// first, so readers never see a partial fragment.
+func (s *DirFragmentStore) Set(key string, value []byte, ttl time.Duration, tags []string) error {
+	header, err := json.Marshal(newFragmentEntry(key, ttl, tags))
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.Dir, "fragment-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	w := bufio.NewWriter(tmp)
+	w.Write(header)
+	w.WriteByte('\n')
+	w.Write(value)
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.file(key))
+}
+
+// Delete implements FragmentStore.
+func (s *DirFragmentStore) Delete(key string) error {
+	err := os.Remove(s.file(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// InvalidateTag implements FragmentStore. It reads the header of every
+// stored fragment, so its cost grows with the size of the store.
+func (s *DirFragmentStore) InvalidateTag(tag string) error {
+	files, err := os.ReadDir(s.Dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if !strings.HasSuffix(file.Name(), ".frag") {
+			continue
+		}
+		name := filepath.Join(s.Dir, file.Name())
+		entry, err := readFragmentHeader(name)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		for _, t := range entry.Tags {
+			if t == tag {
+				if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+					return err
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func readFragmentHeader(name string) (fragmentEntry, error) {
+	var entry fragmentEntry
+	f, err := os.Open(name)
+	if err != nil {
+		return entry, err
+	}
+	defer f.Close()
+	line, err := bufio.NewReader(f).ReadBytes('\n')
+	if err != nil {
+		return entry, errors.New("x: malformed fragment file " + name)
+	}
+	if err := json.Unmarshal(line, &entry); err != nil {
+		return entry, errors.New("x: malformed fragment file " + name)
+	}
+	return entry, nil
+}
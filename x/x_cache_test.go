@@ -0,0 +1,231 @@
+package x
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	stores := map[string]func(t *testing.T) FragmentStore{
+		"Memory": func(t *testing.T) FragmentStore { return NewMemoryFragmentStore(10) },
+		"Dir":    func(t *testing.T) FragmentStore { return NewDirFragmentStore(t.TempDir()) },
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			defer func(s FragmentStore) { DefaultFragmentStore = s }(DefaultFragmentStore)
+			DefaultFragmentStore = newStore(t)
+
+			builds := 0
+			page := func(price int) Elem {
+				return Div(
+					C("Price: "),
+					Cache("product:42:price", time.Hour, func() Elem {
+						builds++
+						return Span(C(price))
+					}, "product:42"),
+				)
+			}
+			render := func(e Elem) string {
+				var buf bytes.Buffer
+				if err := e.Render(&buf); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return buf.String()
+			}
+
+			if got := render(page(10)); got != "<div>Price: <span>10</span></div>" {
+				t.Errorf("unexpected first render %q", got)
+			}
+			if got := render(page(12)); got != "<div>Price: <span>10</span></div>" {
+				t.Errorf("expected the cached fragment, got %q", got)
+			}
+			if builds != 1 {
+				t.Errorf("expected 1 build, got %d", builds)
+			}
+
+			if err := InvalidateTag("product:7"); err != nil {
+				t.Fatalf("InvalidateTag() returned an error: %v", err)
+			}
+			if got := render(page(12)); got != "<div>Price: <span>10</span></div>" {
+				t.Errorf("expected an unrelated tag to keep the fragment, got %q", got)
+			}
+			if err := InvalidateTag("product:42"); err != nil {
+				t.Fatalf("InvalidateTag() returned an error: %v", err)
+			}
+			if got := render(page(12)); got != "<div>Price: <span>12</span></div>" {
+				t.Errorf("expected a fresh fragment after InvalidateTag, got %q", got)
+			}
+
+			if err := DefaultFragmentStore.Set("short", []byte("old"), time.Nanosecond, nil); err != nil {
+				t.Fatalf("Set() returned an error: %v", err)
+			}
+			time.Sleep(time.Millisecond)
+			if _, ok, err := DefaultFragmentStore.Get("short"); ok || err != nil {
+				t.Errorf("expected an expired fragment to miss, got ok=%v err=%v", ok, err)
+			}
+		})
+	}
+}
+
+func TestMemoryFragmentStoreEviction(t *testing.T) {
+	s := NewMemoryFragmentStore(2)
+	s.Set("a", []byte("a"), 0, []string{"t"})
+	s.Set("b", []byte("b"), 0, nil)
+	s.Get("a")
+	s.Set("c", []byte("c"), 0, nil)
+
+	if _, ok, _ := s.Get("b"); ok {
+		t.Errorf("expected the least recently used fragment to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok, _ := s.Get(key); !ok {
+			t.Errorf("expected fragment %q to be kept", key)
+		}
+	}
+	s.InvalidateTag("t")
+	if s.Len() != 1 {
+		t.Errorf("expected 1 fragment after InvalidateTag, got %d", s.Len())
+	}
+}
+
+func TestCacheReplaysEffects(t *testing.T) {
+	store := NewMemoryFragmentStore(10)
+	builds := 0
+	page := Div(
+		Islands(func(names []string) Elem { return C(len(names)) }),
+		CacheIn(store, "widget", 0, func() Elem {
+			builds++
+			return Island("chart", nil, Elem{})
+		}),
+	)
+	render := func() string {
+		var buf bytes.Buffer
+		if err := page.Render(&buf); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return buf.String()
+	}
+
+	expected := `<div>1<div data-island="chart" data-props="null"></div></div>`
+	for i := 0; i < 2; i++ {
+		if got := render(); got != expected {
+			t.Errorf("render %d: expected %q, got %q", i+1, expected, got)
+		}
+	}
+	if builds != 1 {
+		t.Errorf("expected 1 build, got %d", builds)
+	}
+
+	// A fragment stored by another process is rendered again once, to
+	// record its effects.
+	delete(fragmentEffects.m, fragmentRef{store: store, key: "widget"})
+	for i := 0; i < 2; i++ {
+		if got := render(); got != expected {
+			t.Errorf("render %d after a restart: expected %q, got %q", i+1, expected, got)
+		}
+	}
+	if builds != 2 {
+		t.Errorf("expected 2 builds, got %d", builds)
+	}
+}
+
+func TestCacheEffectsPerStore(t *testing.T) {
+	store := NewMemoryFragmentStore(10)
+	builds := 0
+	page := func(store FragmentStore, island string) Elem {
+		return Div(
+			Islands(func(names []string) Elem { return C(strings.Join(names, ",")) }),
+			CacheIn(store, "widget", 0, func() Elem {
+				builds++
+				return Island(island, nil, Elem{})
+			}),
+		)
+	}
+	render := func(e Elem) string {
+		var buf bytes.Buffer
+		if err := e.Render(&buf); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return buf.String()
+	}
+
+	tests := []struct {
+		name     string
+		elem     Elem
+		expected string
+		builds   int
+	}{
+		{
+			name:     "First render",
+			elem:     page(store, "chart"),
+			expected: `<div>chart<div data-island="chart" data-props="null"></div></div>`,
+			builds:   1,
+		},
+		{
+			name:     "Same key in another store",
+			elem:     page(NewMemoryFragmentStore(10), "map"),
+			expected: `<div>map<div data-island="map" data-props="null"></div></div>`,
+			builds:   2,
+		},
+		{
+			name:     "Hit in the first store",
+			elem:     page(store, "chart"),
+			expected: `<div>chart<div data-island="chart" data-props="null"></div></div>`,
+			builds:   2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := render(tt.elem); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+			if builds != tt.builds {
+				t.Errorf("expected %d builds, got %d", tt.builds, builds)
+			}
+		})
+	}
+
+	// A fragment replaced in the store, as by another process, is rendered
+	// again rather than replayed with the effects of the old one.
+	if err := store.Set("widget", []byte("<p>other</p>"), 0, nil); err != nil {
+		t.Fatalf("Set() returned an error: %v", err)
+	}
+	expected := `<div>chart<div data-island="chart" data-props="null"></div></div>`
+	if got := render(page(store, "chart")); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+	if builds != 3 {
+		t.Errorf("expected 3 builds, got %d", builds)
+	}
+}
+
+func TestCacheUntrackedValue(t *testing.T) {
+	page := CacheIn(NewMemoryFragmentStore(10), "untracked", 0, func() Elem {
+		return RenderFunc(func(ctx *RenderContext) error {
+			ctx.SetValue("key", "value")
+			return nil
+		})
+	})
+	if err := page.Render(&bytes.Buffer{}); err == nil {
+		t.Error("expected an error for a value set outside an effect")
+	}
+}
+
+func TestSetFragmentStore(t *testing.T) {
+	store := NewMemoryFragmentStore(10)
+	var buf bytes.Buffer
+	ctx := NewRenderContext(&buf)
+	SetFragmentStore(ctx, store)
+	if err := Cache("context-store", 0, func() Elem { return C("a") }).RenderWith(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok, _ := store.Get("context-store"); !ok {
+		t.Error("expected the fragment in the context store")
+	}
+	if _, ok, _ := DefaultFragmentStore.Get("context-store"); ok {
+		t.Error("expected no fragment in DefaultFragmentStore")
+	}
+}
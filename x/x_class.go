@@ -0,0 +1,79 @@
+package x
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ClassMap holds class names that are included when their value is true.
+// Its names are added in sorted order.
+type ClassMap map[string]bool
+
+// Classes creates a class attribute from names, without empty names or
+// duplicates, in the order they are first given. Each name may be:
+//
+//   - a string of class names separated by spaces, such as ClassIf returns
+//   - a []string of class names
+//   - a ClassMap, whose true entries are added in sorted order
+//
+// Other values are formatted with fmt.Sprint. The attribute is omitted when
+// no names are left:
+//
+//	Classes("btn", ClassIf(active, "active"), ClassMap{"disabled": isDisabled})
+//
+// A class attribute set more than once on an element, for example by
+// Classes and Class, is rendered once with the names of all of them.
+func Classes(names ...interface{}) Elem {
+	var list []string
+	for _, n := range names {
+		switch n := n.(type) {
+		case string:
+			list = append(list, n)
+		case []string:
+			list = append(list, n...)
+		case ClassMap:
+			keys := make([]string, 0, len(n))
+			for k, on := range n {
+				if on {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+			list = append(list, keys...)
+		default:
+			list = append(list, fmt.Sprint(n))
+		}
+	}
+	value := mergeClasses(list)
+	return AttIf(value != "", "class", value)
+}
+
+// ClassIf returns names joined by spaces if cond is true, and an empty
+// string otherwise, for use with Classes.
+func ClassIf(cond bool, names ...string) string {
+	if !cond {
+		return ""
+	}
+	return strings.Join(names, " ")
+}
+
+// mergeClasses joins the class names in values, which may each hold
+// several names, dropping duplicates and keeping the first occurrence.
+func mergeClasses(values []string) string {
+	var b strings.Builder
+	seen := make(map[string]bool)
+	for _, v := range values {
+		for _, name := range strings.Fields(v) {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			if b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(name)
+		}
+	}
+	return b.String()
+}
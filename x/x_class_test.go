@@ -0,0 +1,52 @@
+package x
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestClasses(t *testing.T) {
+	active, disabled := true, false
+	tests := []struct {
+		name     string
+		elem     Elem
+		expected string
+	}{
+		{
+			name:     "Conditional classes",
+			elem:     Button(Classes("btn", ClassIf(active, "active"), ClassMap{"disabled": disabled, "primary": true, "large": true})),
+			expected: `<button class="btn active large primary"></button>`,
+		},
+		{
+			name:     "Duplicates and spacing",
+			elem:     Div(Classes("  a b ", []string{"b", "c"}, ClassIf(false, "d"), "a")),
+			expected: `<div class="a b c"></div>`,
+		},
+		{
+			name:     "No classes left",
+			elem:     Div(Classes(ClassIf(false, "hidden"), ClassMap{"x": false})),
+			expected: `<div></div>`,
+		},
+		{
+			name:     "Merged with other class attributes",
+			elem:     Div(Att("id", "a"), Class("card"), Fragment(Classes("card", "wide")), Att("title", "t"), Class("end")),
+			expected: `<div id="a" class="card wide end" title="t"></div>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.elem.Render(&buf); err != nil {
+				t.Fatalf("Render() returned an error: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, buf.String())
+			}
+		})
+	}
+
+	if v, _ := Div(Class("a"), Classes("b")).Attr("class"); v != "a b" {
+		t.Errorf("expected merged class %q, got %q", "a b", v)
+	}
+}
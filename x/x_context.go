@@ -0,0 +1,145 @@
+package x
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// RenderContext carries state through a single render of a tree. Nodes
+// created with RenderFunc receive it, can record values in it, and can defer
+// output until the whole tree has been rendered, so that a layout's head can
+// depend on what its body used.
+type RenderContext struct {
+	w         io.Writer // final destination
+	out       io.Writer // where output currently goes
+	values    map[interface{}]interface{}
+	deferred  []*deferredOutput
+	resolving bool
+	effects   *[]func(ctx *RenderContext) // effects recorded for Cache, if any
+	inEffect  bool
+	untracked bool // SetValue was called outside an effect while recording
+}
+
+// deferredOutput is a deferred node and the output rendered after it.
+type deferredOutput struct {
+	fn    func(ctx *RenderContext) Elem
+	after bytes.Buffer
+}
+
+// NewRenderContext returns a context rendering to w.
+func NewRenderContext(w io.Writer) *RenderContext {
+	return &RenderContext{w: w, out: w}
+}
+
+// Write writes p to the output at the current position in the tree.
+func (c *RenderContext) Write(p []byte) (int, error) {
+	return c.out.Write(p)
+}
+
+// Render renders e at the current position, sharing the context.
+func (c *RenderContext) Render(e Elem) error {
+	return e.render(c)
+}
+
+// Value returns the value stored under key, or nil.
+func (c *RenderContext) Value(key interface{}) interface{} {
+	return c.values[key]
+}
+
+// SetValue stores val under key. Packages should use an unexported key type
+// to avoid collisions, as with context.Context.
+func (c *RenderContext) SetValue(key, val interface{}) {
+	if c.values == nil {
+		c.values = make(map[interface{}]interface{})
+	}
+	if c.effects != nil && !c.inEffect {
+		c.untracked = true
+	}
+	c.values[key] = val
+}
+
+// Effect runs fn, which records state in the context, such as the islands
+// used. Cache runs the effects of a fragment again each time it reuses its
+// output, so the context ends up as if the fragment had been rendered.
+// Nodes recording state should call SetValue inside an effect; Cache fails
+// on values set otherwise, since it could not restore them.
+func (c *RenderContext) Effect(fn func(ctx *RenderContext)) {
+	inEffect := c.inEffect
+	c.inEffect = true
+	fn(c)
+	c.inEffect = inEffect
+	if c.effects != nil && !inEffect {
+		*c.effects = append(*c.effects, fn)
+	}
+}
+
+// recordEffects calls render and returns the effects run meanwhile, which
+// are also recorded by an enclosing recording. It fails when render sets
+// values outside an effect.
+func (c *RenderContext) recordEffects(render func() error) ([]func(ctx *RenderContext), error) {
+	var effects []func(ctx *RenderContext)
+	outer, untracked := c.effects, c.untracked
+	c.effects, c.untracked = &effects, false
+	err := render()
+	tracked := !c.untracked
+	c.effects, c.untracked = outer, untracked
+	if outer != nil {
+		*outer = append(*outer, effects...)
+	}
+	if err == nil && !tracked {
+		err = errors.New("x: a value was set outside RenderContext.Effect and cannot be replayed from a cached fragment")
+	}
+	return effects, err
+}
+
+// Defer arranges for the element returned by fn to be rendered at the
+// current position once the rest of the tree has been rendered. Output
+// following a deferred node is buffered until then. Deferrals made while
+// deferred nodes are being resolved are rendered immediately.
+func (c *RenderContext) Defer(fn func(ctx *RenderContext) Elem) error {
+	if c.resolving {
+		return fn(c).render(c)
+	}
+	d := &deferredOutput{fn: fn}
+	c.deferred = append(c.deferred, d)
+	c.out = &d.after
+	return nil
+}
+
+// capture renders e with the context into a buffer instead of the output.
+// Deferred nodes inside e are rendered in place.
+func (c *RenderContext) capture(e Elem) ([]byte, error) {
+	var buf bytes.Buffer
+	out, resolving := c.out, c.resolving
+	c.out, c.resolving = &buf, true
+	err := e.render(c)
+	c.out, c.resolving = out, resolving
+	return buf.Bytes(), err
+}
+
+// flush resolves deferred nodes in order and writes the buffered output.
+func (c *RenderContext) flush() error {
+	deferred := c.deferred
+	c.deferred = nil
+	c.resolving = true
+	c.out = c.w
+	defer func() { c.resolving = false }()
+	for _, d := range deferred {
+		if err := d.fn(c).render(c); err != nil {
+			return err
+		}
+		if _, err := d.after.WriteTo(c.w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Deferred creates an Elem rendered by fn after the rest of the tree, at
+// the position it occupies. It lets a head element list what the body used.
+func Deferred(fn func(ctx *RenderContext) Elem) Elem {
+	return RenderFunc(func(ctx *RenderContext) error {
+		return ctx.Defer(fn)
+	})
+}
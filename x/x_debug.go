@@ -0,0 +1,157 @@
+package x
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/zulubit/xxhtml/x/spec"
+)
+
+// String returns the name of the node type.
+func (t NodeType) String() string {
+	switch t {
+	case EmptyNode:
+		return "EmptyNode"
+	case TagNode:
+		return "TagNode"
+	case AttributeNode:
+		return "AttributeNode"
+	case ContentNode:
+		return "ContentNode"
+	case RawContentNode:
+		return "RawContentNode"
+	case FragmentNode:
+		return "FragmentNode"
+	case FuncNode:
+		return "FuncNode"
+	}
+	return "NodeType(" + strconv.Itoa(int(t)) + ")"
+}
+
+// GoString returns Go source that builds the element with the x package,
+// so that %#v prints a tree that can be pasted back into code. Function
+// nodes, such as those of RenderFunc and Cache, cannot be printed: they
+// appear as RenderFunc(nil) with a comment, which compiles and renders
+// nothing until the function is filled in.
+func (e Elem) GoString() string {
+	var b strings.Builder
+	e.writeGo(&b, 0)
+	return b.String()
+}
+
+func (e Elem) writeGo(b *strings.Builder, depth int) {
+	switch e.Type {
+	case EmptyNode:
+		b.WriteString("x.Elem{}")
+	case TagNode:
+		fn, ok := constructorFor(e.Tag)
+		if ok {
+			b.WriteString(fn + "(")
+		} else {
+			b.WriteString("x.E(" + strconv.Quote(e.Tag))
+		}
+		writeGoChildren(b, e.Children, depth, !ok)
+		if el, _ := spec.Lookup(e.Tag); e.SelfCloses && !(ok && el.Void) {
+			b.WriteString(".SelfClose()")
+		}
+	case AttributeNode:
+		switch {
+		case e.AttrKind == AttrBoolean:
+			b.WriteString("x.AttBool(" + strconv.Quote(e.AttrKey) + ", true)")
+		case e.AttrKind == AttrOmitted:
+			b.WriteString("x.AttIf(false, " + strconv.Quote(e.AttrKey) + ", " + strconv.Quote(e.AttrVal) + ")")
+		case e.AttrKey == "class":
+			b.WriteString("x.Class(" + strconv.Quote(e.AttrVal) + ")")
+		default:
+			b.WriteString("x.Att(" + strconv.Quote(e.AttrKey) + ", " + strconv.Quote(e.AttrVal) + ")")
+		}
+	case ContentNode:
+		b.WriteString("x.C(" + strconv.Quote(e.Content) + ")")
+	case RawContentNode:
+		if e.Content == "<!DOCTYPE html>" {
+			b.WriteString("x.DOCTYPE()")
+		} else {
+			b.WriteString("x.CR(" + strconv.Quote(e.Content) + ")")
+		}
+	case FragmentNode:
+		b.WriteString("x.Fragment(")
+		writeGoChildren(b, e.Children, depth, false)
+	case FuncNode:
+		b.WriteString("x.RenderFunc(nil /* FuncNode: not reproducible */)")
+	default:
+		fmt.Fprintf(b, "x.Elem{Type: %d}", int(e.Type))
+	}
+}
+
+// writeGoChildren writes the arguments and closing parenthesis of a call.
+// Children without nested tags stay on one line; otherwise each child gets
+// its own line.
+func writeGoChildren(b *strings.Builder, children []Elem, depth int, afterArg bool) {
+	multiline := false
+	for _, child := range children {
+		if child.Type == TagNode || child.Type == FragmentNode {
+			multiline = true
+			break
+		}
+	}
+
+	if !multiline {
+		for i, child := range children {
+			if i > 0 || afterArg {
+				b.WriteString(", ")
+			}
+			child.writeGo(b, depth)
+		}
+		b.WriteString(")")
+		return
+	}
+
+	if afterArg {
+		b.WriteString(",")
+	}
+	indent := strings.Repeat("\t", depth+1)
+	for _, child := range children {
+		b.WriteString("\n" + indent)
+		child.writeGo(b, depth+1)
+		b.WriteString(",")
+	}
+	b.WriteString("\n" + strings.Repeat("\t", depth) + ")")
+}
+
+// Dump writes an indented tree of the element's node types, tags,
+// attributes and content to w, for debugging.
+func Dump(w io.Writer, e Elem) error {
+	return e.dump(w, 0)
+}
+
+func (e Elem) dump(w io.Writer, depth int) error {
+	line := strings.Repeat("  ", depth) + e.Type.String()
+	switch e.Type {
+	case TagNode:
+		line += " " + e.Tag
+		if e.SelfCloses {
+			line += " (self-closing)"
+		}
+	case AttributeNode:
+		line += " " + e.AttrKey
+		switch e.AttrKind {
+		case AttrValue:
+			line += "=" + strconv.Quote(e.AttrVal)
+		case AttrOmitted:
+			line += " (omitted)"
+		}
+	case ContentNode, RawContentNode:
+		line += " " + strconv.Quote(e.Content)
+	}
+	if _, err := io.WriteString(w, line+"\n"); err != nil {
+		return err
+	}
+	for _, child := range e.Children {
+		if err := child.dump(w, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,483 @@
+package x
+
+import (
+	"bytes"
+	"crypto/rand"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PatchOp is the kind of DOM operation described by a Patch.
+type PatchOp string
+
+// Patch operations produced by Diff.
+const (
+	OpSetAttr    PatchOp = "setAttr"    // Set attribute Name to Value on the node at Path
+	OpRemoveAttr PatchOp = "removeAttr" // Remove attribute Name from the node at Path
+	OpSetText    PatchOp = "setText"    // Replace the text of the text node at Path with Value
+	OpReplace    PatchOp = "replace"    // Replace the node at Path with the node parsed from HTML
+	OpInsert     PatchOp = "insert"     // Insert the node parsed from HTML as child Index of the node at Path
+	OpRemove     PatchOp = "remove"     // Remove child Index of the node at Path
+	OpMove       PatchOp = "move"       // Remove child From of the node at Path and reinsert it as child Index
+)
+
+// Patch is a single DOM operation. Path holds child node indices leading
+// from the container the trees are rendered into down to the target node,
+// and is valid once the preceding patches have been applied.
+type Patch struct {
+	Op    PatchOp `json:"op"`
+	Path  []int   `json:"path"`
+	Name  string  `json:"name,omitempty"`
+	Value string  `json:"value,omitempty"`
+	HTML  string  `json:"html,omitempty"`
+	Index int     `json:"index,omitempty"`
+	From  int     `json:"from,omitempty"`
+}
+
+// DiffScript is a small JavaScript applier for patches produced by Diff. It
+// defines applyPatches(container, patches), where patches is the decoded
+// JSON of the patch list.
+//
+//go:embed x_diff.js
+var DiffScript string
+
+// Diff computes the DOM operations turning the rendered old tree into the
+// rendered new tree. Both trees are taken as the content of a container
+// element, so the path of a root tag is [0]. Children carrying a "key"
+// attribute are matched by key and moved when reordered; other children are
+// matched by position. Elements containing raw content, or the output of a
+// RenderFunc, Deferred or Cache node, are compared as a whole and replaced
+// when their HTML differs.
+//
+// Each tree is rendered once with its own RenderContext, as a page is, so
+// function nodes see the state recorded by the rest of the tree, such as
+// the islands used. Diff returns the error of a tree that fails to render.
+//
+// Paths follow the DOM the browser builds from the rendered HTML, including
+// the tbody it adds around table rows, so markup the browser would repair
+// otherwise, such as a div inside a p, yields paths that do not match.
+func Diff(old, new Elem) ([]Patch, error) {
+	old, err := resolveFuncs(old)
+	if err != nil {
+		return nil, fmt.Errorf("rendering the old tree: %w", err)
+	}
+	new, err = resolveFuncs(new)
+	if err != nil {
+		return nil, fmt.Errorf("rendering the new tree: %w", err)
+	}
+	var d differ
+	d.diffChildren([]int{}, diffNodes([]Elem{old}, ""), diffNodes([]Elem{new}, ""))
+	if d.err != nil {
+		return nil, d.err
+	}
+	return d.patches, nil
+}
+
+// resolveFuncs renders e and returns a copy of it in which every function
+// node is replaced by the raw HTML it wrote, or by an empty node when it
+// wrote nothing. The output of each function is found between comment
+// markers written around it, which also enclose deferred output once the
+// context has been flushed.
+func resolveFuncs(e Elem) (Elem, error) {
+	nonce := make([]byte, 8)
+	if _, err := rand.Read(nonce); err != nil {
+		return e, err
+	}
+	markers := func(i int) (string, string) {
+		id := hex.EncodeToString(nonce) + "-" + strconv.Itoa(i)
+		return "<!--xdiff:" + id + "-->", "<!--/xdiff:" + id + "-->"
+	}
+
+	n := 0
+	marked := mapFuncs(e, func(f Elem) Elem {
+		open, end := markers(n)
+		n++
+		return RenderFunc(func(ctx *RenderContext) error {
+			if _, err := ctx.Write([]byte(open)); err != nil {
+				return err
+			}
+			if f.Func != nil {
+				if err := f.Func(ctx); err != nil {
+					return err
+				}
+			}
+			_, err := ctx.Write([]byte(end))
+			return err
+		})
+	})
+	if n == 0 {
+		return e, nil
+	}
+	var buf bytes.Buffer
+	if err := marked.RenderWith(NewRenderContext(&buf)); err != nil {
+		return e, err
+	}
+
+	out := buf.String()
+	i := 0
+	var err error
+	resolved := mapFuncs(e, func(Elem) Elem {
+		open, end := markers(i)
+		i++
+		start := strings.Index(out, open)
+		stop := strings.Index(out, end)
+		if start < 0 || stop < start {
+			if err == nil {
+				err = errors.New("function node output not found")
+			}
+			return Elem{}
+		}
+		if html := out[start+len(open) : stop]; html != "" {
+			return CR(html)
+		}
+		return Elem{}
+	})
+	return resolved, err
+}
+
+// mapFuncs returns a copy of e with every function node replaced by the
+// result of fn, called in document order.
+func mapFuncs(e Elem, fn func(Elem) Elem) Elem {
+	if e.Type == FuncNode {
+		return fn(e)
+	}
+	if len(e.Children) == 0 {
+		return e
+	}
+	children := make([]Elem, len(e.Children))
+	for i, child := range e.Children {
+		children[i] = mapFuncs(child, fn)
+	}
+	e.Children = children
+	return e
+}
+
+// MarshalPatches encodes patches as a JSON array, for DiffScript.
+func MarshalPatches(patches []Patch) ([]byte, error) {
+	if patches == nil {
+		patches = []Patch{}
+	}
+	return json.Marshal(patches)
+}
+
+// diffNode is a DOM node as the browser sees the rendered tree: fragments
+// and empty nodes are flattened away and adjacent text is merged.
+type diffNode struct {
+	typ      NodeType // TagNode, ContentNode or RawContentNode
+	tag      string
+	text     string
+	key      string
+	attrs    []Elem
+	children []diffNode
+	opaque   bool // contains raw content, so its DOM structure is unknown
+	elem     Elem // renders the node
+}
+
+// diffNodes normalizes children into DOM nodes. parent is the tag of the
+// enclosing element, used to add the tbody a browser inserts around rows.
+func diffNodes(children []Elem, parent string) []diffNode {
+	var nodes []diffNode
+	eachContent(children, func(child Elem) bool {
+		switch child.Type {
+		case ContentNode:
+			if child.Content == "" {
+				break
+			}
+			if n := len(nodes); n > 0 && nodes[n-1].typ == ContentNode {
+				nodes[n-1].text += child.Content
+				nodes[n-1].elem = C(nodes[n-1].text)
+				break
+			}
+			nodes = append(nodes, diffNode{typ: ContentNode, text: child.Content, elem: child})
+		case RawContentNode:
+			// The output of function nodes, resolved by Diff, is raw
+			// content too.
+			nodes = append(nodes, diffNode{typ: RawContentNode, text: child.Content, elem: child})
+		case TagNode:
+			nodes = append(nodes, newDiffNode(child))
+		}
+		return true
+	})
+
+	if strings.EqualFold(parent, "table") {
+		nodes = wrapRows(nodes)
+	}
+	return nodes
+}
+
+func newDiffNode(e Elem) diffNode {
+	n := diffNode{typ: TagNode, tag: strings.ToLower(e.Tag), elem: e}
+	seen := make(map[string]bool)
+	for _, a := range attrsOf(e.Children) {
+		if !seen[a.AttrKey] {
+			seen[a.AttrKey] = true
+			n.attrs = append(n.attrs, a)
+			if a.AttrKey == "key" {
+				n.key = a.AttrVal
+			}
+		}
+	}
+	n.children = diffNodes(e.Children, e.Tag)
+	for _, c := range n.children {
+		if c.typ == RawContentNode {
+			n.opaque = true
+		}
+	}
+	return n
+}
+
+// wrapRows groups runs of rows placed directly in a table into the tbody
+// the browser creates for them.
+func wrapRows(nodes []diffNode) []diffNode {
+	var out []diffNode
+	for i := 0; i < len(nodes); i++ {
+		if nodes[i].tag != "tr" {
+			out = append(out, nodes[i])
+			continue
+		}
+		j := i
+		var rows []Elem
+		for j < len(nodes) && nodes[j].tag == "tr" {
+			rows = append(rows, nodes[j].elem)
+			j++
+		}
+		out = append(out, diffNode{
+			typ:      TagNode,
+			tag:      "tbody",
+			children: nodes[i:j:j],
+			elem:     E("tbody", rows...),
+		})
+		i = j - 1
+	}
+	return out
+}
+
+// html renders n, recording the first render error.
+func (d *differ) html(n diffNode) string {
+	var buf bytes.Buffer
+	if err := n.elem.Render(&buf); err != nil && d.err == nil {
+		d.err = err
+	}
+	return buf.String()
+}
+
+// compatible reports whether o can be patched into n rather than replaced.
+func compatible(o, n diffNode) bool {
+	return o.typ == n.typ && o.tag == n.tag && o.key == n.key
+}
+
+type differ struct {
+	patches []Patch
+	err     error
+}
+
+func (d *differ) add(p Patch) {
+	p.Path = append([]int{}, p.Path...)
+	d.patches = append(d.patches, p)
+}
+
+func (d *differ) diffNode(path []int, o, n diffNode) {
+	if !compatible(o, n) {
+		d.add(Patch{Op: OpReplace, Path: path, HTML: d.html(n)})
+		return
+	}
+	switch o.typ {
+	case ContentNode:
+		if o.text != n.text {
+			d.add(Patch{Op: OpSetText, Path: path, Value: n.text})
+		}
+		return
+	case RawContentNode:
+		if o.text != n.text {
+			d.add(Patch{Op: OpReplace, Path: path, HTML: d.html(n)})
+		}
+		return
+	}
+
+	if o.opaque || n.opaque {
+		if d.html(o) != d.html(n) {
+			d.add(Patch{Op: OpReplace, Path: path, HTML: d.html(n)})
+		}
+		return
+	}
+
+	newAttrs := make(map[string]string, len(n.attrs))
+	for _, a := range n.attrs {
+		newAttrs[a.AttrKey] = a.AttrVal
+	}
+	oldAttrs := make(map[string]string, len(o.attrs))
+	for _, a := range o.attrs {
+		oldAttrs[a.AttrKey] = a.AttrVal
+		if _, ok := newAttrs[a.AttrKey]; !ok {
+			d.add(Patch{Op: OpRemoveAttr, Path: path, Name: a.AttrKey})
+		}
+	}
+	for _, a := range n.attrs {
+		if v, ok := oldAttrs[a.AttrKey]; !ok || v != a.AttrVal {
+			d.add(Patch{Op: OpSetAttr, Path: path, Name: a.AttrKey, Value: a.AttrVal})
+		}
+	}
+
+	d.diffChildren(path, o.children, n.children)
+}
+
+// diffChildren reconciles the children of the node at path, then diffs the
+// matched children in their final positions.
+func (d *differ) diffChildren(path []int, old, new []diffNode) {
+	match := matchChildren(old, new)
+
+	// Remove unmatched old children, last first so indices stay valid.
+	used := make([]bool, len(old))
+	for _, j := range match {
+		if j >= 0 {
+			used[j] = true
+		}
+	}
+	var current []int // old index of each current child; negative ids mark inserted ones
+	for j := len(old) - 1; j >= 0; j-- {
+		if !used[j] {
+			d.add(Patch{Op: OpRemove, Path: path, Index: j})
+		}
+	}
+	for j := range old {
+		if used[j] {
+			current = append(current, j)
+		}
+	}
+
+	// Children on the longest increasing run of old positions stay put;
+	// the others are moved or inserted, from the end, before their final
+	// next sibling.
+	stable := longestIncreasing(match)
+	indexOf := func(oldIndex int) int {
+		for i, j := range current {
+			if j == oldIndex {
+				return i
+			}
+		}
+		return -1
+	}
+	inserted := -2 // marker for inserted children, never an old index
+	for i := len(new) - 1; i >= 0; i-- {
+		if match[i] >= 0 && stable[i] {
+			continue
+		}
+		before := len(current)
+		if i+1 < len(new) {
+			before = indexOf(finalID(match, i+1, inserted))
+		}
+		if match[i] >= 0 {
+			from := indexOf(match[i])
+			current = append(current[:from], current[from+1:]...)
+			if from < before {
+				before--
+			}
+			d.add(Patch{Op: OpMove, Path: path, From: from, Index: before})
+			current = insertAt(current, before, match[i])
+			continue
+		}
+		d.add(Patch{Op: OpInsert, Path: path, Index: before, HTML: d.html(new[i])})
+		current = insertAt(current, before, inserted-i)
+	}
+
+	for i, j := range match {
+		if j >= 0 {
+			d.diffNode(append(path[:len(path):len(path)], i), old[j], new[i])
+		}
+	}
+}
+
+// finalID returns the id used in the current list for new child i.
+func finalID(match []int, i, inserted int) int {
+	if match[i] >= 0 {
+		return match[i]
+	}
+	return inserted - i
+}
+
+func insertAt(s []int, i, v int) []int {
+	s = append(s, 0)
+	copy(s[i+1:], s[i:])
+	s[i] = v
+	return s
+}
+
+// matchChildren returns, for each new child, the index of the old child it
+// updates, or -1. Keyed children match by key; the others match the old
+// child at the same position among unkeyed children.
+func matchChildren(old, new []diffNode) []int {
+	keyed := make(map[string]int)
+	var unkeyed []int
+	for j, o := range old {
+		if o.key != "" {
+			if _, dup := keyed[o.key]; !dup {
+				keyed[o.key] = j
+			}
+			continue
+		}
+		unkeyed = append(unkeyed, j)
+	}
+
+	match := make([]int, len(new))
+	taken := make(map[int]bool)
+	u := 0
+	for i, n := range new {
+		match[i] = -1
+		if n.key != "" {
+			if j, ok := keyed[n.key]; ok && !taken[j] && compatible(old[j], n) {
+				match[i] = j
+				taken[j] = true
+			}
+			continue
+		}
+		if u < len(unkeyed) {
+			// Incompatible nodes still pair up, to be replaced in place.
+			match[i] = unkeyed[u]
+			u++
+		}
+	}
+	return match
+}
+
+// longestIncreasing marks the matched positions forming the longest run of
+// increasing old indices.
+func longestIncreasing(match []int) []bool {
+	var tails []int // positions in match ending the best run of each length
+	prev := make([]int, len(match))
+	for i, j := range match {
+		prev[i] = -1
+		if j < 0 {
+			continue
+		}
+		lo, hi := 0, len(tails)
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if match[tails[mid]] < j {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+		if lo > 0 {
+			prev[i] = tails[lo-1]
+		}
+		if lo == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[lo] = i
+		}
+	}
+
+	stable := make([]bool, len(match))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+			stable[i] = true
+		}
+	}
+	return stable
+}
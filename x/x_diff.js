@@ -0,0 +1,40 @@
+// applyPatches applies patches produced by x.Diff to the DOM under container,
+// the element the old tree was rendered into.
+function applyPatches(container, patches) {
+  const at = (path) => path.reduce((node, i) => node.childNodes[i], container);
+  const parse = (html) => {
+    const t = document.createElement("template");
+    t.innerHTML = html;
+    return t.content.firstChild || document.createTextNode("");
+  };
+  for (const p of patches) {
+    const index = p.index || 0;
+    const node = at(p.path);
+    switch (p.op) {
+      case "setAttr":
+        node.setAttribute(p.name, p.value || "");
+        break;
+      case "removeAttr":
+        node.removeAttribute(p.name);
+        break;
+      case "setText":
+        node.nodeValue = p.value || "";
+        break;
+      case "replace":
+        node.replaceWith(parse(p.html));
+        break;
+      case "insert":
+        node.insertBefore(parse(p.html), node.childNodes[index] || null);
+        break;
+      case "remove":
+        node.removeChild(node.childNodes[index]);
+        break;
+      case "move": {
+        const child = node.childNodes[p.from || 0];
+        node.removeChild(child);
+        node.insertBefore(child, node.childNodes[index] || null);
+        break;
+      }
+    }
+  }
+}
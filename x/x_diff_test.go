@@ -0,0 +1,314 @@
+package x
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// parseContainer parses rendered HTML into a container element, as a browser
+// would when the HTML is assigned to a div's innerHTML.
+func parseContainer(t *testing.T, e Elem) *html.Node {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := e.Render(&buf); err != nil {
+		t.Fatalf("Render() returned an error: %v", err)
+	}
+	container := &html.Node{Type: html.ElementNode, Data: "div", DataAtom: atom.Div}
+	nodes, err := html.ParseFragment(&buf, container)
+	if err != nil {
+		t.Fatalf("ParseFragment() returned an error: %v", err)
+	}
+	for _, n := range nodes {
+		container.AppendChild(n)
+	}
+	return container
+}
+
+// applyPatches mirrors x_diff.js on a parsed tree.
+func applyPatches(t *testing.T, container *html.Node, patches []Patch) {
+	t.Helper()
+	child := func(n *html.Node, i int) *html.Node {
+		c := n.FirstChild
+		for ; i > 0 && c != nil; i-- {
+			c = c.NextSibling
+		}
+		return c
+	}
+	parse := func(context *html.Node, s string) *html.Node {
+		nodes, err := html.ParseFragment(strings.NewReader(s), &html.Node{Type: html.ElementNode, Data: "template", DataAtom: atom.Template})
+		if err != nil || len(nodes) == 0 {
+			t.Fatalf("cannot parse patch HTML %q: %v", s, err)
+		}
+		return nodes[0]
+	}
+	for _, p := range patches {
+		node := container
+		for _, i := range p.Path {
+			node = child(node, i)
+			if node == nil {
+				t.Fatalf("patch %+v: path does not exist", p)
+			}
+		}
+		switch p.Op {
+		case OpSetAttr:
+			found := false
+			for i, a := range node.Attr {
+				if a.Key == p.Name {
+					node.Attr[i].Val, found = p.Value, true
+				}
+			}
+			if !found {
+				node.Attr = append(node.Attr, html.Attribute{Key: p.Name, Val: p.Value})
+			}
+		case OpRemoveAttr:
+			var attrs []html.Attribute
+			for _, a := range node.Attr {
+				if a.Key != p.Name {
+					attrs = append(attrs, a)
+				}
+			}
+			node.Attr = attrs
+		case OpSetText:
+			node.Data = p.Value
+		case OpReplace:
+			node.Parent.InsertBefore(parse(node.Parent, p.HTML), node)
+			node.Parent.RemoveChild(node)
+		case OpInsert:
+			node.InsertBefore(parse(node, p.HTML), child(node, p.Index))
+		case OpRemove:
+			node.RemoveChild(child(node, p.Index))
+		case OpMove:
+			c := child(node, p.From)
+			node.RemoveChild(c)
+			node.InsertBefore(c, child(node, p.Index))
+		}
+	}
+}
+
+func renderNode(t *testing.T, n *html.Node) string {
+	t.Helper()
+	var buf bytes.Buffer
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if err := html.Render(&buf, c); err != nil {
+			t.Fatalf("html.Render() returned an error: %v", err)
+		}
+	}
+	return buf.String()
+}
+
+func keyedList(keys ...string) Elem {
+	var items []Elem
+	for _, k := range keys {
+		items = append(items, Li(Att("key", k), C(k)))
+	}
+	return Ul(items...)
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new Elem
+		patches  int
+	}{
+		{
+			name:    "Identical trees",
+			old:     Div(Class("a"), P(C("Hi"))),
+			new:     Div(Class("a"), P(C("Hi"))),
+			patches: 0,
+		},
+		{
+			name:    "Attributes and text",
+			old:     Div(Class("a"), Att("title", "t"), P(C("Hi"))),
+			new:     Div(Class("b"), Att("id", "x"), P(C("Hello"), C(" there"))),
+			patches: 4,
+		},
+		{
+			name:    "Keyed rotation is one move",
+			old:     keyedList("a", "b", "c", "d"),
+			new:     keyedList("b", "c", "d", "a"),
+			patches: 1,
+		},
+		{
+			name:    "Keyed insert, remove and reorder",
+			old:     keyedList("a", "b", "c", "d", "e"),
+			new:     keyedList("e", "x", "c", "a", "y"),
+			patches: 6,
+		},
+		{
+			name:    "Unkeyed children appended and replaced",
+			old:     Div(P(C("1")), Span(C("2"))),
+			new:     Div(P(C("1")), Div(C("2")), Span(C("3"))),
+			patches: 2,
+		},
+		{
+			name:    "Table rows inside implied tbody",
+			old:     Table(Tr(Att("key", "1"), Td(C("one"))), Tr(Att("key", "2"), Td(C("two")))),
+			new:     Table(Tr(Att("key", "2"), Td(C("two!"))), Tr(Att("key", "3"), Td(C("three")))),
+			patches: 3,
+		},
+		{
+			name:    "Raw content replaces the element",
+			old:     Div(P(CR("<b>bold</b>"))),
+			new:     Div(P(CR("<i>italic</i>"))),
+			patches: 1,
+		},
+		{
+			name:    "Function output replaces the element",
+			old:     Div(cachedBold(), P(C("a"))),
+			new:     Div(cachedBold(), P(C("b"))),
+			patches: 1,
+		},
+		{
+			name:    "Deferred output replaces the element",
+			old:     Div(Deferred(func(*RenderContext) Elem { return Span(C("d")) }), P(C("a"))),
+			new:     Div(Deferred(func(*RenderContext) Elem { return Span(C("d")) }), P(C("b"))),
+			patches: 1,
+		},
+		{
+			name:    "Functions without output take no position",
+			old:     Div(RenderFunc(func(*RenderContext) error { return nil }), P(C("a"))),
+			new:     Div(RenderFunc(func(*RenderContext) error { return nil }), P(C("b"))),
+			patches: 1,
+		},
+		{
+			name:    "Deferred output sees the whole tree",
+			old:     Div(Islands(func(names []string) Elem { return C(len(names)) }), P(C("a"))),
+			new:     Div(Islands(func(names []string) Elem { return C(len(names)) }), Island("chart", nil, Elem{})),
+			patches: 1,
+		},
+		{
+			name:    "Fragments and root replacement",
+			old:     Fragment(H1(C("Title")), IF(true, P(C("x")))),
+			new:     Fragment(H2(C("Title")), IF(false, P(C("x"))), Footer()),
+			patches: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches, err := Diff(tt.old, tt.new)
+			if err != nil {
+				t.Fatalf("Diff() returned an error: %v", err)
+			}
+			if len(patches) != tt.patches {
+				t.Errorf("expected %d patches, got %d: %+v", tt.patches, len(patches), patches)
+			}
+
+			container := parseContainer(t, tt.old)
+			applyPatches(t, container, patches)
+			got, expected := renderNode(t, container), renderNode(t, parseContainer(t, tt.new))
+			if got != expected {
+				t.Errorf("patched DOM differs:\nexpected %s\ngot      %s\npatches %+v", expected, got, patches)
+			}
+		})
+	}
+}
+
+// cachedBold writes its markup at render time, as a cached fragment does.
+func cachedBold() Elem {
+	return RenderFunc(func(ctx *RenderContext) error {
+		_, err := ctx.Write([]byte("<b>cached</b>"))
+		return err
+	})
+}
+
+func TestDiff_RenderError(t *testing.T) {
+	failing := Div(RenderFunc(func(*RenderContext) error { return errors.New("boom") }), P(C("a")))
+	if _, err := Diff(Div(P(C("a"))), failing); err == nil {
+		t.Error("expected an error for a tree that fails to render")
+	}
+	if _, err := Diff(failing, Div(P(C("a")))); err == nil {
+		t.Error("expected an error for an old tree that fails to render")
+	}
+}
+
+func TestMarshalPatches(t *testing.T) {
+	patches, err := Diff(Div(Class("a")), Div(Class("b")))
+	if err != nil {
+		t.Fatalf("Diff() returned an error: %v", err)
+	}
+	b, err := MarshalPatches(patches)
+	if err != nil {
+		t.Fatalf("MarshalPatches() returned an error: %v", err)
+	}
+	expected := `[{"op":"setAttr","path":[0],"name":"class","value":"b"}]`
+	if string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, b)
+	}
+
+	b, _ = MarshalPatches(nil)
+	if string(b) != "[]" {
+		t.Errorf("expected an empty array, got %s", b)
+	}
+}
+
+func TestDiff_Server(t *testing.T) {
+	// Serves the page, the applier script and the patches to the next
+	// version, as a page updating itself would fetch them.
+	versions := []Elem{keyedList("a", "b"), keyedList("b", "c")}
+	mux := http.NewServeMux()
+	mux.Handle("GET /{$}", Handler(func(r *http.Request) (Elem, error) {
+		return Html(Body(Div(Att("id", "app"), versions[0]), Script(CR(DiffScript)))), nil
+	}))
+	mux.HandleFunc("GET /patches", func(w http.ResponseWriter, r *http.Request) {
+		patches, err := Diff(versions[0], versions[1])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		b, _ := MarshalPatches(patches)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + "/")
+	if err != nil {
+		t.Fatalf("GET / returned an error: %v", err)
+	}
+	doc, err := html.Parse(res.Body)
+	res.Body.Close()
+	if err != nil {
+		t.Fatalf("html.Parse() returned an error: %v", err)
+	}
+	var app *html.Node
+	var find func(*html.Node)
+	find = func(n *html.Node) {
+		for _, a := range n.Attr {
+			if a.Key == "id" && a.Val == "app" {
+				app = n
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			find(c)
+		}
+	}
+	find(doc)
+	if app == nil {
+		t.Fatal("container #app not found")
+	}
+
+	res, err = http.Get(srv.URL + "/patches")
+	if err != nil {
+		t.Fatalf("GET /patches returned an error: %v", err)
+	}
+	var patches []Patch
+	if err := json.NewDecoder(res.Body).Decode(&patches); err != nil {
+		t.Fatalf("decoding patches: %v", err)
+	}
+	res.Body.Close()
+
+	applyPatches(t, app, patches)
+	if got, expected := renderNode(t, app), renderNode(t, parseContainer(t, versions[1])); got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
@@ -1,50 +1,137 @@
+// Code generated by internal/genhtml from package spec. DO NOT EDIT.
+
 package x
 
 // Convenience functions
 
-// Div creates a new <div> element with optional children.
-func Div(children ...Elem) Elem {
-	return E("div", children...)
+// Html creates a new <html> (root) element with optional children.
+func Html(children ...Elem) Elem {
+	return E("html", children...)
 }
 
-// Span creates a new <span> element with optional children.
-func Span(children ...Elem) Elem {
-	return E("span", children...)
+// Head creates a new <head> (document metadata) element with optional children.
+func Head(children ...Elem) Elem {
+	return E("head", children...)
 }
 
-// P creates a new <p> (paragraph) element with optional children.
-func P(children ...Elem) Elem {
-	return E("p", children...)
+// Title creates a new <title> (document title) element with optional children.
+func Title(children ...Elem) Elem {
+	return E("title", children...)
 }
 
-// A creates a new <a> (anchor) element with optional children.
-func A(children ...Elem) Elem {
-	return E("a", children...)
+// Base creates a new <base> (document base URL) element. This is a self-closing tag.
+func Base(children ...Elem) Elem {
+	return E("base", children...).SelfClose()
 }
 
-// Img creates a new <img> (image) element. This is a self-closing tag.
-func Img(children ...Elem) Elem {
-	return E("img", children...).SelfClose()
+// Link creates a new <link> (external resource link) element. This is a self-closing tag.
+func Link(children ...Elem) Elem {
+	return E("link", children...).SelfClose()
+}
+
+// Meta creates a new <meta> (metadata) element. This is a self-closing tag.
+func Meta(children ...Elem) Elem {
+	return E("meta", children...).SelfClose()
+}
+
+// Style creates a new <style> (style information) element with optional children.
+func Style(children ...Elem) Elem {
+	return E("style", children...)
+}
+
+// Body creates a new <body> (document body) element with optional children.
+func Body(children ...Elem) Elem {
+	return E("body", children...)
+}
+
+// Article creates a new <article> element with optional children.
+func Article(children ...Elem) Elem {
+	return E("article", children...)
 }
 
-// H1 creates a new <h1> (heading) element with optional children.
+// Section creates a new <section> element with optional children.
+func Section(children ...Elem) Elem {
+	return E("section", children...)
+}
+
+// Nav creates a new <nav> (navigation section) element with optional children.
+func Nav(children ...Elem) Elem {
+	return E("nav", children...)
+}
+
+// Aside creates a new <aside> element with optional children.
+func Aside(children ...Elem) Elem {
+	return E("aside", children...)
+}
+
+// H1 creates a new <h1> (level 1 heading) element with optional children.
 func H1(children ...Elem) Elem {
 	return E("h1", children...)
 }
 
-// H2 creates a new <h2> (heading) element with optional children.
+// H2 creates a new <h2> (level 2 heading) element with optional children.
 func H2(children ...Elem) Elem {
 	return E("h2", children...)
 }
 
-// H3 creates a new <h3> (heading) element with optional children.
+// H3 creates a new <h3> (level 3 heading) element with optional children.
 func H3(children ...Elem) Elem {
 	return E("h3", children...)
 }
 
-// Ul creates a new <ul> (unordered list) element with optional children.
-func Ul(children ...Elem) Elem {
-	return E("ul", children...)
+// H4 creates a new <h4> (level 4 heading) element with optional children.
+func H4(children ...Elem) Elem {
+	return E("h4", children...)
+}
+
+// H5 creates a new <h5> (level 5 heading) element with optional children.
+func H5(children ...Elem) Elem {
+	return E("h5", children...)
+}
+
+// H6 creates a new <h6> (level 6 heading) element with optional children.
+func H6(children ...Elem) Elem {
+	return E("h6", children...)
+}
+
+// Hgroup creates a new <hgroup> (heading group) element with optional children.
+func Hgroup(children ...Elem) Elem {
+	return E("hgroup", children...)
+}
+
+// Header creates a new <header> element with optional children.
+func Header(children ...Elem) Elem {
+	return E("header", children...)
+}
+
+// Footer creates a new <footer> element with optional children.
+func Footer(children ...Elem) Elem {
+	return E("footer", children...)
+}
+
+// Address creates a new <address> (contact information) element with optional children.
+func Address(children ...Elem) Elem {
+	return E("address", children...)
+}
+
+// P creates a new <p> (paragraph) element with optional children.
+func P(children ...Elem) Elem {
+	return E("p", children...)
+}
+
+// Hr creates a new <hr> (thematic break) element. This is a self-closing tag.
+func Hr(children ...Elem) Elem {
+	return E("hr", children...).SelfClose()
+}
+
+// Pre creates a new <pre> (preformatted text) element with optional children.
+func Pre(children ...Elem) Elem {
+	return E("pre", children...)
+}
+
+// Blockquote creates a new <blockquote> (block quotation) element with optional children.
+func Blockquote(children ...Elem) Elem {
+	return E("blockquote", children...)
 }
 
 // Ol creates a new <ol> (ordered list) element with optional children.
@@ -52,16 +139,316 @@ func Ol(children ...Elem) Elem {
 	return E("ol", children...)
 }
 
+// Ul creates a new <ul> (unordered list) element with optional children.
+func Ul(children ...Elem) Elem {
+	return E("ul", children...)
+}
+
+// Menu creates a new <menu> (toolbar menu) element with optional children.
+func Menu(children ...Elem) Elem {
+	return E("menu", children...)
+}
+
 // Li creates a new <li> (list item) element with optional children.
 func Li(children ...Elem) Elem {
 	return E("li", children...)
 }
 
+// Dl creates a new <dl> (description list) element with optional children.
+func Dl(children ...Elem) Elem {
+	return E("dl", children...)
+}
+
+// Dt creates a new <dt> (description term) element with optional children.
+func Dt(children ...Elem) Elem {
+	return E("dt", children...)
+}
+
+// Dd creates a new <dd> (description details) element with optional children.
+func Dd(children ...Elem) Elem {
+	return E("dd", children...)
+}
+
+// Figure creates a new <figure> element with optional children.
+func Figure(children ...Elem) Elem {
+	return E("figure", children...)
+}
+
+// Figcaption creates a new <figcaption> (figure caption) element with optional children.
+func Figcaption(children ...Elem) Elem {
+	return E("figcaption", children...)
+}
+
+// Main creates a new <main> (main content) element with optional children.
+func Main(children ...Elem) Elem {
+	return E("main", children...)
+}
+
+// Search creates a new <search> (search section) element with optional children.
+func Search(children ...Elem) Elem {
+	return E("search", children...)
+}
+
+// Div creates a new <div> (content division) element with optional children.
+func Div(children ...Elem) Elem {
+	return E("div", children...)
+}
+
+// A creates a new <a> (anchor) element with optional children.
+func A(children ...Elem) Elem {
+	return E("a", children...)
+}
+
+// Em creates a new <em> (emphasis) element with optional children.
+func Em(children ...Elem) Elem {
+	return E("em", children...)
+}
+
+// Strong creates a new <strong> (strong importance) element with optional children.
+func Strong(children ...Elem) Elem {
+	return E("strong", children...)
+}
+
+// Small creates a new <small> (side comment) element with optional children.
+func Small(children ...Elem) Elem {
+	return E("small", children...)
+}
+
+// S creates a new <s> (strikethrough) element with optional children.
+func S(children ...Elem) Elem {
+	return E("s", children...)
+}
+
+// Cite creates a new <cite> (citation) element with optional children.
+func Cite(children ...Elem) Elem {
+	return E("cite", children...)
+}
+
+// Q creates a new <q> (inline quotation) element with optional children.
+func Q(children ...Elem) Elem {
+	return E("q", children...)
+}
+
+// Dfn creates a new <dfn> (definition) element with optional children.
+func Dfn(children ...Elem) Elem {
+	return E("dfn", children...)
+}
+
+// Abbr creates a new <abbr> (abbreviation) element with optional children.
+func Abbr(children ...Elem) Elem {
+	return E("abbr", children...)
+}
+
+// Ruby creates a new <ruby> (ruby annotation) element with optional children.
+func Ruby(children ...Elem) Elem {
+	return E("ruby", children...)
+}
+
+// Rt creates a new <rt> (ruby text) element with optional children.
+func Rt(children ...Elem) Elem {
+	return E("rt", children...)
+}
+
+// Rp creates a new <rp> (ruby parenthesis) element with optional children.
+func Rp(children ...Elem) Elem {
+	return E("rp", children...)
+}
+
+// DataElem creates a new <data> (machine-readable data) element with optional children.
+func DataElem(children ...Elem) Elem {
+	return E("data", children...)
+}
+
+// Time creates a new <time> element with optional children.
+func Time(children ...Elem) Elem {
+	return E("time", children...)
+}
+
+// Code creates a new <code> element with optional children.
+func Code(children ...Elem) Elem {
+	return E("code", children...)
+}
+
+// Var creates a new <var> (variable) element with optional children.
+func Var(children ...Elem) Elem {
+	return E("var", children...)
+}
+
+// Samp creates a new <samp> (sample output) element with optional children.
+func Samp(children ...Elem) Elem {
+	return E("samp", children...)
+}
+
+// Kbd creates a new <kbd> (keyboard input) element with optional children.
+func Kbd(children ...Elem) Elem {
+	return E("kbd", children...)
+}
+
+// Sub creates a new <sub> (subscript) element with optional children.
+func Sub(children ...Elem) Elem {
+	return E("sub", children...)
+}
+
+// Sup creates a new <sup> (superscript) element with optional children.
+func Sup(children ...Elem) Elem {
+	return E("sup", children...)
+}
+
+// I creates a new <i> (idiomatic text) element with optional children.
+func I(children ...Elem) Elem {
+	return E("i", children...)
+}
+
+// B creates a new <b> (bring attention) element with optional children.
+func B(children ...Elem) Elem {
+	return E("b", children...)
+}
+
+// U creates a new <u> (unarticulated annotation) element with optional children.
+func U(children ...Elem) Elem {
+	return E("u", children...)
+}
+
+// Mark creates a new <mark> (highlight) element with optional children.
+func Mark(children ...Elem) Elem {
+	return E("mark", children...)
+}
+
+// Bdi creates a new <bdi> (bidirectional isolate) element with optional children.
+func Bdi(children ...Elem) Elem {
+	return E("bdi", children...)
+}
+
+// Bdo creates a new <bdo> (bidirectional override) element with optional children.
+func Bdo(children ...Elem) Elem {
+	return E("bdo", children...)
+}
+
+// Span creates a new <span> element with optional children.
+func Span(children ...Elem) Elem {
+	return E("span", children...)
+}
+
+// Br creates a new <br> (line break) element. This is a self-closing tag.
+func Br(children ...Elem) Elem {
+	return E("br", children...).SelfClose()
+}
+
+// Wbr creates a new <wbr> (line break opportunity) element. This is a self-closing tag.
+func Wbr(children ...Elem) Elem {
+	return E("wbr", children...).SelfClose()
+}
+
+// Ins creates a new <ins> (inserted text) element with optional children.
+func Ins(children ...Elem) Elem {
+	return E("ins", children...)
+}
+
+// Del creates a new <del> (deleted text) element with optional children.
+func Del(children ...Elem) Elem {
+	return E("del", children...)
+}
+
+// Picture creates a new <picture> element with optional children.
+func Picture(children ...Elem) Elem {
+	return E("picture", children...)
+}
+
+// Source creates a new <source> (media source) element. This is a self-closing tag.
+func Source(children ...Elem) Elem {
+	return E("source", children...).SelfClose()
+}
+
+// Img creates a new <img> (image) element. This is a self-closing tag.
+func Img(children ...Elem) Elem {
+	return E("img", children...).SelfClose()
+}
+
+// Iframe creates a new <iframe> (inline frame) element with optional children.
+func Iframe(children ...Elem) Elem {
+	return E("iframe", children...)
+}
+
+// Embed creates a new <embed> (embedded content) element. This is a self-closing tag.
+func Embed(children ...Elem) Elem {
+	return E("embed", children...).SelfClose()
+}
+
+// Object creates a new <object> (external object) element with optional children.
+func Object(children ...Elem) Elem {
+	return E("object", children...)
+}
+
+// Video creates a new <video> element with optional children.
+func Video(children ...Elem) Elem {
+	return E("video", children...)
+}
+
+// Audio creates a new <audio> element with optional children.
+func Audio(children ...Elem) Elem {
+	return E("audio", children...)
+}
+
+// Track creates a new <track> (text track) element. This is a self-closing tag.
+func Track(children ...Elem) Elem {
+	return E("track", children...).SelfClose()
+}
+
+// Map creates a new <map> (image map) element with optional children.
+func Map(children ...Elem) Elem {
+	return E("map", children...)
+}
+
+// Area creates a new <area> (image map area) element. This is a self-closing tag.
+func Area(children ...Elem) Elem {
+	return E("area", children...).SelfClose()
+}
+
+// Math creates a new <math> (MathML math) element with optional children.
+func Math(children ...Elem) Elem {
+	return E("math", children...)
+}
+
+// Svg creates a new <svg> (SVG graphics) element with optional children.
+func Svg(children ...Elem) Elem {
+	return E("svg", children...)
+}
+
 // Table creates a new <table> element with optional children.
 func Table(children ...Elem) Elem {
 	return E("table", children...)
 }
 
+// Caption creates a new <caption> (table caption) element with optional children.
+func Caption(children ...Elem) Elem {
+	return E("caption", children...)
+}
+
+// Colgroup creates a new <colgroup> (table column group) element with optional children.
+func Colgroup(children ...Elem) Elem {
+	return E("colgroup", children...)
+}
+
+// Col creates a new <col> (table column) element. This is a self-closing tag.
+func Col(children ...Elem) Elem {
+	return E("col", children...).SelfClose()
+}
+
+// Tbody creates a new <tbody> (table body) element with optional children.
+func Tbody(children ...Elem) Elem {
+	return E("tbody", children...)
+}
+
+// Thead creates a new <thead> (table head) element with optional children.
+func Thead(children ...Elem) Elem {
+	return E("thead", children...)
+}
+
+// Tfoot creates a new <tfoot> (table foot) element with optional children.
+func Tfoot(children ...Elem) Elem {
+	return E("tfoot", children...)
+}
+
 // Tr creates a new <tr> (table row) element with optional children.
 func Tr(children ...Elem) Elem {
 	return E("tr", children...)
@@ -82,6 +469,11 @@ func Form(children ...Elem) Elem {
 	return E("form", children...)
 }
 
+// Label creates a new <label> element with optional children.
+func Label(children ...Elem) Elem {
+	return E("label", children...)
+}
+
 // Input creates a new <input> element. This is a self-closing tag.
 func Input(children ...Elem) Elem {
 	return E("input", children...).SelfClose()
@@ -92,72 +484,62 @@ func Button(children ...Elem) Elem {
 	return E("button", children...)
 }
 
-// Label creates a new <label> element with optional children.
-func Label(children ...Elem) Elem {
-	return E("label", children...)
+// Select creates a new <select> element with optional children.
+func Select(children ...Elem) Elem {
+	return E("select", children...)
 }
 
-// Article creates a new <article> element with optional children.
-func Article(children ...Elem) Elem {
-	return E("article", children...)
+// Datalist creates a new <datalist> element with optional children.
+func Datalist(children ...Elem) Elem {
+	return E("datalist", children...)
 }
 
-// Aside creates a new <aside> element with optional children.
-func Aside(children ...Elem) Elem {
-	return E("aside", children...)
+// Optgroup creates a new <optgroup> (option group) element with optional children.
+func Optgroup(children ...Elem) Elem {
+	return E("optgroup", children...)
 }
 
-// Header creates a new <header> element with optional children.
-func Header(children ...Elem) Elem {
-	return E("header", children...)
+// Option creates a new <option> element with optional children.
+func Option(children ...Elem) Elem {
+	return E("option", children...)
 }
 
-// Footer creates a new <footer> element with optional children.
-func Footer(children ...Elem) Elem {
-	return E("footer", children...)
+// Textarea creates a new <textarea> (multi-line text input) element with optional children.
+func Textarea(children ...Elem) Elem {
+	return E("textarea", children...)
 }
 
-// Main creates a new <main> element with optional children.
-func Main(children ...Elem) Elem {
-	return E("main", children...)
+// Output creates a new <output> element with optional children.
+func Output(children ...Elem) Elem {
+	return E("output", children...)
 }
 
-// Section creates a new <section> element with optional children.
-func Section(children ...Elem) Elem {
-	return E("section", children...)
+// Progress creates a new <progress> (progress indicator) element with optional children.
+func Progress(children ...Elem) Elem {
+	return E("progress", children...)
 }
 
-// Nav creates a new <nav> element with optional children.
-func Nav(children ...Elem) Elem {
-	return E("nav", children...)
+// Meter creates a new <meter> (gauge) element with optional children.
+func Meter(children ...Elem) Elem {
+	return E("meter", children...)
 }
 
-// Figure creates a new <figure> element with optional children.
-func Figure(children ...Elem) Elem {
-	return E("figure", children...)
-}
-
-// Figcaption creates a new <figcaption> element with optional children.
-func Figcaption(children ...Elem) Elem {
-	return E("figcaption", children...)
-}
-
-// Datalist creates a new <datalist> element with optional children.
-func Datalist(children ...Elem) Elem {
-	return E("datalist", children...)
+// Fieldset creates a new <fieldset> (form control group) element with optional children.
+func Fieldset(children ...Elem) Elem {
+	return E("fieldset", children...)
 }
 
-// Option creates a new <option> element.
-func Option(children ...Elem) Elem {
-	return E("option", children...).SelfClose()
+// Legend creates a new <legend> (fieldset caption) element with optional children.
+func Legend(children ...Elem) Elem {
+	return E("legend", children...)
 }
 
-// Details creates a new <details> element with optional children.
+// Details creates a new <details> (disclosure widget) element with optional children.
 func Details(children ...Elem) Elem {
 	return E("details", children...)
 }
 
-// Summary creates a new <summary> element with optional children.
+// Summary creates a new <summary> (disclosure summary) element with optional children.
 func Summary(children ...Elem) Elem {
 	return E("summary", children...)
 }
@@ -167,82 +549,39 @@ func Dialog(children ...Elem) Elem {
 	return E("dialog", children...)
 }
 
-// Embed creates a new <embed> element. This is a self-closing tag.
-func Embed(children ...Elem) Elem {
-	return E("embed", children...).SelfClose()
-}
-
-// Map creates a new <map> element with optional children.
-func Map(children ...Elem) Elem {
-	return E("map", children...)
-}
-
-// Area creates a new <area> element. This is a self-closing tag.
-func Area(children ...Elem) Elem {
-	return E("area", children...).SelfClose()
-}
-
-// Source creates a new <source> element. This is a self-closing tag.
-func Source(children ...Elem) Elem {
-	return E("source", children...).SelfClose()
-}
-
-// Track creates a new <track> element. This is a self-closing tag.
-func Track(children ...Elem) Elem {
-	return E("track", children...).SelfClose()
-}
-
-// Param creates a new <param> element. This is a self-closing tag.
-func Param(children ...Elem) Elem {
-	return E("param", children...).SelfClose()
-}
-
 // Script creates a new <script> element with optional children.
 func Script(children ...Elem) Elem {
 	return E("script", children...)
 }
 
-// Style creates a new <style> element with optional children.
-func Style(children ...Elem) Elem {
-	return E("style", children...)
+// Noscript creates a new <noscript> (scripting fallback) element with optional children.
+func Noscript(children ...Elem) Elem {
+	return E("noscript", children...)
 }
 
-// Meta creates a new <meta> element. This is a self-closing tag.
-func Meta(children ...Elem) Elem {
-	return E("meta", children...).SelfClose()
+// Template creates a new <template> (content template) element with optional children.
+func Template(children ...Elem) Elem {
+	return E("template", children...)
 }
 
-// Link creates a new <link> element. This is a self-closing tag.
-func Link(children ...Elem) Elem {
-	return E("link", children...).SelfClose()
+// Slot creates a new <slot> (shadow tree slot) element with optional children.
+func Slot(children ...Elem) Elem {
+	return E("slot", children...)
 }
 
-// Title creates a new <title> element with optional children.
-func Title(children ...Elem) Elem {
-	return E("title", children...)
+// Canvas creates a new <canvas> (graphics canvas) element with optional children.
+func Canvas(children ...Elem) Elem {
+	return E("canvas", children...)
 }
 
-// Base creates a new <base> element. This is a self-closing tag.
-func Base(children ...Elem) Elem {
-	return E("base", children...).SelfClose()
+// Param creates a new <param> (object parameter) element. This is a self-closing tag.
+//
+// Deprecated: <param> is obsolete in the HTML standard.
+func Param(children ...Elem) Elem {
+	return E("param", children...).SelfClose()
 }
 
 // DOCTYPE generates the raw <!DOCTYPE html> declaration.
 func DOCTYPE() Elem {
 	return CR("<!DOCTYPE html>")
 }
-
-// Html creates a new <html> element with optional children.
-func Html(children ...Elem) Elem {
-	return E("html", children...)
-}
-
-// Head creates a new <head> element with optional children.
-func Head(children ...Elem) Elem {
-	return E("head", children...)
-}
-
-// Body creates a new <body> element with optional children.
-func Body(children ...Elem) Elem {
-	return E("body", children...)
-}
@@ -0,0 +1,68 @@
+package x
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// islandsKey is the RenderContext key holding the islands used by a render.
+type islandsKey struct{}
+
+// Island renders fallback inside a div carrying data-island="name" and the
+// props encoded as JSON in data-props, for a client script to hydrate. The
+// island is recorded in the render context, so a layout can load only the
+// scripts of the islands on the page with Islands or IslandScripts. Props
+// that cannot be encoded make rendering fail.
+func Island(name string, props interface{}, fallback Elem) Elem {
+	data, err := json.Marshal(props)
+	record := RenderFunc(func(ctx *RenderContext) error {
+		if err != nil {
+			return fmt.Errorf("island %q: %w", name, err)
+		}
+		ctx.Effect(func(ctx *RenderContext) {
+			used, _ := ctx.Value(islandsKey{}).([]string)
+			for _, n := range used {
+				if n == name {
+					return
+				}
+			}
+			ctx.SetValue(islandsKey{}, append(used, name))
+		})
+		return nil
+	})
+	return Div(
+		Att("data-island", name),
+		Att("data-props", string(data)),
+		record,
+		fallback,
+	)
+}
+
+// IslandsUsed returns the names of the islands rendered so far with ctx, in
+// the order they first appeared.
+func IslandsUsed(ctx *RenderContext) []string {
+	used, _ := ctx.Value(islandsKey{}).([]string)
+	return append([]string(nil), used...)
+}
+
+// Islands creates an Elem rendered by fn once the whole tree has been
+// rendered, with the names of the islands it used.
+func Islands(fn func(names []string) Elem) Elem {
+	return Deferred(func(ctx *RenderContext) Elem {
+		return fn(IslandsUsed(ctx))
+	})
+}
+
+// IslandScripts renders a module script tag for each island used by the
+// page, taking its source from srcs. Islands without an entry are skipped.
+func IslandScripts(srcs map[string]string) Elem {
+	return Islands(func(names []string) Elem {
+		var scripts []Elem
+		for _, name := range names {
+			if src, ok := srcs[name]; ok {
+				scripts = append(scripts, Script(Att("type", "module"), Att("src", src)))
+			}
+		}
+		return Fragment(scripts...)
+	})
+}
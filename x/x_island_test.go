@@ -0,0 +1,107 @@
+package x
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIsland(t *testing.T) {
+	tests := []struct {
+		name     string
+		elem     Elem
+		expected string
+	}{
+		{
+			name:     "Props as JSON",
+			elem:     Island("chart", map[string]int{"points": 3}, P(C("Loading"))),
+			expected: `<div data-island="chart" data-props="{&#34;points&#34;:3}"><p>Loading</p></div>`,
+		},
+		{
+			name:     "Props escaped",
+			elem:     Island("note", struct{ Text string }{`</div><script>"x"`}, Elem{}),
+			expected: `<div data-island="note" data-props="{&#34;Text&#34;:&#34;\u003c/div\u003e\u003cscript\u003e\&#34;x\&#34;&#34;}"></div>`,
+		},
+		{
+			name:     "Nil props",
+			elem:     Island("clock", nil, C("12:00")),
+			expected: `<div data-island="clock" data-props="null">12:00</div>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.elem.Render(&buf); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := buf.String(); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIslandInvalidProps(t *testing.T) {
+	var buf bytes.Buffer
+	err := Island("bad", make(chan int), Elem{}).Render(&buf)
+	if err == nil || !strings.Contains(err.Error(), `island "bad"`) {
+		t.Errorf("expected props error, got %v", err)
+	}
+}
+
+func TestIslandScripts(t *testing.T) {
+	page := Html(
+		Head(IslandScripts(map[string]string{
+			"chart":  "/js/chart.js",
+			"picker": "/js/picker.js",
+			"map":    "/js/map.js",
+		})),
+		Body(
+			Island("picker", nil, Elem{}),
+			Island("chart", nil, Elem{}),
+			Island("picker", nil, Elem{}),
+			Island("inline", nil, Elem{}),
+		),
+	)
+
+	var buf bytes.Buffer
+	if err := page.Render(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `<html><head>` +
+		`<script type="module" src="/js/picker.js"></script>` +
+		`<script type="module" src="/js/chart.js"></script>` +
+		`</head><body>` +
+		`<div data-island="picker" data-props="null"></div>` +
+		`<div data-island="chart" data-props="null"></div>` +
+		`<div data-island="picker" data-props="null"></div>` +
+		`<div data-island="inline" data-props="null"></div>` +
+		`</body></html>`
+	if got := buf.String(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestRenderWith(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := NewRenderContext(&buf)
+	page := Div(
+		Deferred(func(ctx *RenderContext) Elem { return C(strings.Join(IslandsUsed(ctx), ",")) }),
+		Island("a", nil, Elem{}),
+		Deferred(func(ctx *RenderContext) Elem { return C(len(IslandsUsed(ctx))) }),
+		Island("b", nil, Elem{}),
+	)
+	if err := page.RenderWith(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `<div>a,b<div data-island="a" data-props="null"></div>2<div data-island="b" data-props="null"></div></div>`
+	if got := buf.String(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+	if got := IslandsUsed(ctx); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("expected islands [a b], got %v", got)
+	}
+}
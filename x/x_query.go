@@ -0,0 +1,433 @@
+package x
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	// ErrNotFound is returned when no element matches an id or selector.
+	ErrNotFound = errors.New("element not found")
+	// ErrDuplicate is returned when more than one element matches an id or selector.
+	ErrDuplicate = errors.New("element is not unique")
+)
+
+// Attr returns the value of the first attribute named key on a tag,
+// including attributes nested in fragments. The value of a class or style
+// attribute set more than once is the merged value that is rendered.
+func (e Elem) Attr(key string) (string, bool) {
+	for _, a := range attrsOf(e.Children) {
+		if a.AttrKey == key {
+			return a.AttrVal, true
+		}
+	}
+	return "", false
+}
+
+// Attrs returns the attributes of a tag as they are rendered: including
+// attributes nested in fragments, without omitted ones, and with a class or
+// style attribute set more than once merged into one.
+func (e Elem) Attrs() []Elem {
+	return attrsOf(e.Children)
+}
+
+// eachAttr calls fn for every attribute among children that is not
+// omitted, looking through fragments, until fn returns false.
+func eachAttr(children []Elem, fn func(Elem) bool) bool {
+	for _, child := range children {
+		switch child.Type {
+		case AttributeNode:
+			if child.AttrKind == AttrOmitted {
+				continue
+			}
+			if !fn(child) {
+				return false
+			}
+		case FragmentNode:
+			if !eachAttr(child.Children, fn) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// eachContent calls fn for every non-attribute, non-empty node among
+// children, looking through fragments, until fn returns false.
+func eachContent(children []Elem, fn func(Elem) bool) bool {
+	for _, child := range children {
+		switch child.Type {
+		case AttributeNode, EmptyNode:
+		case FragmentNode:
+			if !eachContent(child.Children, fn) {
+				return false
+			}
+		default:
+			if !fn(child) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// RenderChildren writes the content of the element without its own tag.
+func (e Elem) RenderChildren(w io.Writer) error {
+	if e.Type != TagNode && e.Type != FragmentNode {
+		return e.Render(w)
+	}
+	return Fragment(e.Children...).Render(w)
+}
+
+// FindByID returns the element whose id attribute is id. It returns an error
+// wrapping ErrNotFound or ErrDuplicate unless exactly one element matches.
+func FindByID(e Elem, id string) (Elem, error) {
+	var matches []Elem
+	walkTags(e, nil, func(el Elem, _ []Elem) {
+		if v, ok := el.Attr("id"); ok && v == id {
+			matches = append(matches, el)
+		}
+	})
+	return single(matches, fmt.Sprintf("id %q", id))
+}
+
+// RenderByID renders only the element whose id attribute is id, for fragment
+// responses built from the same code as the full page.
+func RenderByID(w io.Writer, e Elem, id string) error {
+	el, err := FindByID(e, id)
+	if err != nil {
+		return err
+	}
+	return el.Render(w)
+}
+
+// Query returns the elements matching a CSS selector, in document order.
+// Supported selectors are tag names, *, #id, .class, [attr], [attr=value],
+// the descendant and child (>) combinators, and comma-separated groups.
+// Attribute values may be quoted, as in [title="a, b"]. Other attribute
+// operators, such as [class~=a], are rejected.
+func Query(e Elem, selector string) ([]Elem, error) {
+	groups, err := parseSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	var matches []Elem
+	walkTags(e, nil, func(el Elem, ancestors []Elem) {
+		for _, g := range groups {
+			if g.match(el, ancestors) {
+				matches = append(matches, el)
+				return
+			}
+		}
+	})
+	return matches, nil
+}
+
+// RenderBySelector renders only the element matching a CSS selector. It
+// returns an error wrapping ErrNotFound or ErrDuplicate unless exactly one
+// element matches.
+func RenderBySelector(w io.Writer, e Elem, selector string) error {
+	matches, err := Query(e, selector)
+	if err != nil {
+		return err
+	}
+	el, err := single(matches, fmt.Sprintf("selector %q", selector))
+	if err != nil {
+		return err
+	}
+	return el.Render(w)
+}
+
+func single(matches []Elem, what string) (Elem, error) {
+	switch len(matches) {
+	case 0:
+		return Elem{}, fmt.Errorf("%s: %w", what, ErrNotFound)
+	case 1:
+		return matches[0], nil
+	}
+	return Elem{}, fmt.Errorf("%s matches %d elements: %w", what, len(matches), ErrDuplicate)
+}
+
+// walkTags calls fn for e and every tag below it, in document order, with the
+// enclosing tags from the outermost down.
+func walkTags(e Elem, ancestors []Elem, fn func(el Elem, ancestors []Elem)) {
+	switch e.Type {
+	case TagNode:
+		fn(e, ancestors)
+		ancestors = append(ancestors[:len(ancestors):len(ancestors)], e)
+	case FragmentNode:
+	default:
+		return
+	}
+	eachContent(e.Children, func(child Elem) bool {
+		walkTags(child, ancestors, fn)
+		return true
+	})
+}
+
+// Walk calls fn for e and every tag below it, in document order, with the
+// element's path, as reported by Validate, and its enclosing tags from the
+// outermost down. When fn returns false the element's children are skipped.
+func Walk(e Elem, fn func(el Elem, path string, ancestors []Elem) bool) {
+	walkPaths([]Elem{e}, "", nil, fn)
+}
+
+func walkPaths(children []Elem, prefix string, ancestors []Elem, fn func(Elem, string, []Elem) bool) {
+	var tags []Elem
+	eachContent(children, func(child Elem) bool {
+		if child.Type == TagNode {
+			tags = append(tags, child)
+		}
+		return true
+	})
+	for i, el := range tags {
+		path := prefix + segment(el, tags, i)
+		if fn(el, path, ancestors) {
+			walkPaths(el.Children, path+" > ", append(ancestors[:len(ancestors):len(ancestors)], el), fn)
+		}
+	}
+}
+
+// Text returns the text content of the element and its descendants, as the
+// browser's textContent would. Raw content is not included.
+func (e Elem) Text() string {
+	var b strings.Builder
+	var collect func(children []Elem)
+	collect = func(children []Elem) {
+		eachContent(children, func(child Elem) bool {
+			switch child.Type {
+			case ContentNode:
+				b.WriteString(child.Content)
+			case TagNode:
+				collect(child.Children)
+			}
+			return true
+		})
+	}
+	collect([]Elem{e})
+	return b.String()
+}
+
+// compound is a selector without combinators, such as div#main.wide[data-x].
+type compound struct {
+	tag     string
+	id      string
+	classes []string
+	attrs   [][2]string // key and value; value "\x00" matches any value
+}
+
+func (c compound) match(e Elem) bool {
+	if c.tag != "" && c.tag != "*" && !strings.EqualFold(c.tag, e.Tag) {
+		return false
+	}
+	if c.id != "" {
+		if v, ok := e.Attr("id"); !ok || v != c.id {
+			return false
+		}
+	}
+	for _, class := range c.classes {
+		if !hasClass(e, class) {
+			return false
+		}
+	}
+	for _, a := range c.attrs {
+		v, ok := e.Attr(a[0])
+		if !ok || a[1] != "\x00" && v != a[1] {
+			return false
+		}
+	}
+	return true
+}
+
+// hasClass reports whether any class attribute of e contains class.
+func hasClass(e Elem, class string) bool {
+	found := false
+	eachAttr(e.Children, func(a Elem) bool {
+		if a.AttrKey == "class" {
+			for _, c := range strings.Fields(a.AttrVal) {
+				if c == class {
+					found = true
+					return false
+				}
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// complexSelector is a chain of compounds joined by combinators, stored
+// right to left.
+type complexSelector struct {
+	parts []compound
+	child []bool // child[i] is true if parts[i] must be a direct child of parts[i+1]
+}
+
+func (s complexSelector) match(e Elem, ancestors []Elem) bool {
+	if !s.parts[0].match(e) {
+		return false
+	}
+	return s.matchAncestors(1, ancestors)
+}
+
+func (s complexSelector) matchAncestors(i int, ancestors []Elem) bool {
+	if i == len(s.parts) {
+		return true
+	}
+	for j := len(ancestors) - 1; j >= 0; j-- {
+		if s.parts[i].match(ancestors[j]) && s.matchAncestors(i+1, ancestors[:j]) {
+			return true
+		}
+		if s.child[i-1] {
+			return false
+		}
+	}
+	return false
+}
+
+// parseSelector parses a comma-separated group of selectors.
+func parseSelector(selector string) ([]complexSelector, error) {
+	list, err := selectorTokens(selector)
+	if err != nil {
+		return nil, err
+	}
+	var groups []complexSelector
+	for _, tokens := range list {
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("invalid selector %q", selector)
+		}
+		var s complexSelector
+		childNext := false
+		for i := len(tokens) - 1; i >= 0; i-- {
+			if tokens[i] == ">" {
+				if childNext || i == 0 || i == len(tokens)-1 {
+					return nil, fmt.Errorf("invalid selector %q", selector)
+				}
+				childNext = true
+				continue
+			}
+			c, err := parseCompound(tokens[i])
+			if err != nil {
+				return nil, fmt.Errorf("invalid selector %q: %w", selector, err)
+			}
+			if len(s.parts) > 0 {
+				s.child = append(s.child, childNext)
+			}
+			s.parts = append(s.parts, c)
+			childNext = false
+		}
+		groups = append(groups, s)
+	}
+	return groups, nil
+}
+
+// selectorTokens splits a selector list into groups at its commas, and each
+// group into compound selectors and ">" combinators. Commas, spaces and ">"
+// inside attribute selectors, such as [title="a, b"], are left in place.
+func selectorTokens(selector string) ([][]string, error) {
+	var groups [][]string
+	var tokens []string
+	start := -1
+	end := func(i int) {
+		if start >= 0 {
+			tokens = append(tokens, selector[start:i])
+			start = -1
+		}
+	}
+	for i := 0; i < len(selector); i++ {
+		switch c := selector[i]; c {
+		case '[':
+			j := attrSelectorEnd(selector, i)
+			if j < 0 {
+				return nil, fmt.Errorf("invalid selector %q: unclosed attribute selector", selector)
+			}
+			if start < 0 {
+				start = i
+			}
+			i = j
+		case ',':
+			end(i)
+			groups = append(groups, tokens)
+			tokens = nil
+		case '>':
+			end(i)
+			tokens = append(tokens, ">")
+		case ' ', '\t', '\n', '\r', '\f':
+			end(i)
+		default:
+			if start < 0 {
+				start = i
+			}
+		}
+	}
+	end(len(selector))
+	return append(groups, tokens), nil
+}
+
+// attrSelectorEnd returns the index of the bracket closing the attribute
+// selector that starts at s[start], skipping quoted values, or -1.
+func attrSelectorEnd(s string, start int) int {
+	var quote byte
+	for i := start + 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ']':
+			return i
+		}
+	}
+	return -1
+}
+
+func parseCompound(s string) (compound, error) {
+	var c compound
+	i := 0
+	name := func() string {
+		start := i
+		for i < len(s) && !strings.ContainsRune("#.[", rune(s[i])) {
+			i++
+		}
+		return s[start:i]
+	}
+	c.tag = name()
+	for i < len(s) {
+		switch s[i] {
+		case '#':
+			i++
+			c.id = name()
+		case '.':
+			i++
+			c.classes = append(c.classes, name())
+		case '[':
+			end := attrSelectorEnd(s, i)
+			if end < 0 {
+				return c, fmt.Errorf("unclosed attribute selector in %q", s)
+			}
+			inner := s[i+1 : end]
+			i = end + 1
+			key, val, ok := strings.Cut(inner, "=")
+			key, val = strings.TrimSpace(key), strings.TrimSpace(val)
+			if key == "" || strings.ContainsAny(key, "~|^$*!\"' \t\n\r\f") {
+				return c, fmt.Errorf("unsupported attribute selector [%s]", inner)
+			}
+			if !ok {
+				val = "\x00"
+			} else if n := len(val); n >= 2 && (val[0] == '"' || val[0] == '\'') && val[n-1] == val[0] {
+				val = val[1 : n-1]
+			}
+			c.attrs = append(c.attrs, [2]string{key, val})
+		}
+	}
+	if c.tag == "" && c.id == "" && len(c.classes) == 0 && len(c.attrs) == 0 {
+		return c, fmt.Errorf("empty selector")
+	}
+	return c, nil
+}
@@ -0,0 +1,169 @@
+package x
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func queryTestPage() Elem {
+	return Html(
+		Body(
+			Nav(Class("menu"), Ul(Li(A(Att("href", "/"), Att("title", "a b, c > d"), C("Home"))))),
+			Div(Att("id", "cart"), Class("panel wide"),
+				Ul(Li(Class("item"), C("Apple")), Li(Class("item"), C("Pear"))),
+			),
+			Div(Att("id", "twice")),
+			Div(Att("id", "twice")),
+		),
+	)
+}
+
+func TestRenderByID(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "Unique id",
+			id:       "cart",
+			expected: `<div id="cart" class="panel wide"><ul><li class="item">Apple</li><li class="item">Pear</li></ul></div>`,
+		},
+		{
+			name:        "Missing id",
+			id:          "missing",
+			expectedErr: ErrNotFound,
+		},
+		{
+			name:        "Duplicated id",
+			id:          "twice",
+			expectedErr: ErrDuplicate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := RenderByID(&buf, queryTestPage(), tt.id)
+			if !errors.Is(err, tt.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tt.expectedErr, err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestRenderChildren(t *testing.T) {
+	el, err := FindByID(queryTestPage(), "cart")
+	if err != nil {
+		t.Fatalf("FindByID() returned an error: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := el.RenderChildren(&buf); err != nil {
+		t.Fatalf("RenderChildren() returned an error: %v", err)
+	}
+	expected := `<ul><li class="item">Apple</li><li class="item">Pear</li></ul>`
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestQuery(t *testing.T) {
+	tests := []struct {
+		selector string
+		count    int
+	}{
+		{selector: "li", count: 3},
+		{selector: "#cart li.item", count: 2},
+		{selector: "div.panel.wide > ul", count: 1},
+		{selector: "body > ul", count: 0},
+		{selector: "nav a[href='/']", count: 1},
+		{selector: "nav, [id=twice]", count: 3},
+		{selector: `a[title="a b, c > d"]`, count: 1},
+		{selector: `a[title='a b, c > d'], li.item`, count: 3},
+		{selector: `a[title="a b"]`, count: 0},
+		{selector: `[title="a,b"], nav`, count: 1},
+		{selector: `a[ href = "/" ]`, count: 1},
+		{selector: "[ id ]", count: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			matches, err := Query(queryTestPage(), tt.selector)
+			if err != nil {
+				t.Fatalf("Query() returned an error: %v", err)
+			}
+			if len(matches) != tt.count {
+				t.Errorf("expected %d matches, got %d", tt.count, len(matches))
+			}
+		})
+	}
+
+	var buf bytes.Buffer
+	if err := RenderBySelector(&buf, queryTestPage(), "nav > ul"); err != nil {
+		t.Fatalf("RenderBySelector() returned an error: %v", err)
+	}
+	expected := `<ul><li><a href="/" title="a b, c &gt; d">Home</a></li></ul>`
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+	for _, selector := range []string{"> li", `a[title="]`, "li,", "[class~=item]", "[href^=/]", "[title*=b]", "[]", "[a b]"} {
+		if _, err := Query(queryTestPage(), selector); err == nil {
+			t.Errorf("expected an error for the invalid selector %q", selector)
+		}
+	}
+}
+
+func TestAttrs(t *testing.T) {
+	e := Div(Class("a"), Att("id", "x"), Fragment(Class("b"), AttIf(false, "hidden", "")), AttBool("inert", true))
+	var got []string
+	for _, a := range e.Attrs() {
+		got = append(got, a.AttrKey+"="+a.AttrVal)
+	}
+	expected := []string{"class=a b", "id=x", "inert="}
+	if fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	var paths []string
+	Walk(queryTestPage(), func(el Elem, path string, ancestors []Elem) bool {
+		paths = append(paths, path)
+		if len(ancestors) > 0 && ancestors[len(ancestors)-1].Tag == "body" && el.Tag != "nav" && el.Tag != "div" {
+			t.Errorf("unexpected child of body: %s", el.Tag)
+		}
+		return el.Tag != "ul"
+	})
+
+	expected := []string{
+		"html",
+		"html > body",
+		"html > body > nav",
+		"html > body > nav > ul",
+		"html > body > div#cart",
+		"html > body > div#cart > ul",
+		"html > body > div#twice",
+		"html > body > div#twice",
+	}
+	if len(paths) != len(expected) {
+		t.Fatalf("expected %q, got %q", expected, paths)
+	}
+	for i := range expected {
+		if paths[i] != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], paths[i])
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	e := Div(Class("x"), C("Hello, "), Fragment(Span(C("<world>"))), CR("<b>raw</b>"), C("!"))
+	if got := e.Text(); got != "Hello, <world>!" {
+		t.Errorf("expected %q, got %q", "Hello, <world>!", got)
+	}
+}
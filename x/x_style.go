@@ -0,0 +1,219 @@
+package x
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// StyleMap holds CSS declarations keyed by property name, such as
+// StyleMap{"color": c, "width": Px(w)}.
+type StyleMap map[string]string
+
+// Styles creates a style attribute from the declarations of styles, where
+// a later map overrides an earlier one for the same property. Values are
+// escaped with EscapeCSSValue, so they cannot end their declaration or
+// start another. Declarations with an empty value or a property name that
+// is not valid are dropped, and an empty value in a later map removes the
+// property. The rest are sorted by property name. The attribute is omitted
+// when no declarations are left:
+//
+//	Styles(StyleMap{"color": c, "width": Px(w)})
+//
+// A style attribute set more than once on an element, for example by Styles
+// and StyleAttr, is rendered once with the declarations of all of them.
+func Styles(styles ...StyleMap) Elem {
+	merged := make(map[string]string)
+	for _, m := range styles {
+		for prop, value := range m {
+			value = strings.TrimSpace(value)
+			if !ValidCSSProperty(prop) || value == "" {
+				delete(merged, prop)
+				continue
+			}
+			merged[prop] = EscapeCSSValue(value)
+		}
+	}
+	value := formatDeclarations(merged)
+	return AttIf(value != "", "style", value)
+}
+
+// number is a Go number type, for the CSS unit helpers.
+type number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
+}
+
+// Px formats n as a CSS length in pixels, such as "12px" or "0.5px".
+// NaN and infinities give an empty string, which Styles drops.
+func Px[N number](n N) string {
+	return unit(float64(n), "px")
+}
+
+// Rem formats n as a CSS length in rem, such as "2rem".
+func Rem[N number](n N) string {
+	return unit(float64(n), "rem")
+}
+
+// Percent formats n as a CSS percentage, such as "50%".
+func Percent[N number](n N) string {
+	return unit(float64(n), "%")
+}
+
+func unit(n float64, suffix string) string {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return ""
+	}
+	return strconv.FormatFloat(n, 'f', -1, 64) + suffix
+}
+
+// ValidCSSProperty reports whether name is a valid CSS property name: an
+// identifier of ASCII letters, digits and hyphens that does not start with
+// a digit, such as "color", "-webkit-box-orient" or the custom property
+// "--accent".
+func ValidCSSProperty(name string) bool {
+	rest := strings.TrimPrefix(name, "--")
+	if rest == name {
+		rest = strings.TrimPrefix(name, "-")
+		if rest == "" || !(rest[0] >= 'a' && rest[0] <= 'z' || rest[0] >= 'A' && rest[0] <= 'Z') {
+			return false
+		}
+	}
+	if rest == "" {
+		return false
+	}
+	for _, r := range rest {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// EscapeCSSValue escapes a CSS property value so that it stays inside its
+// declaration: semicolons, braces, backslashes, comment openers, control
+// characters, angle brackets and unmatched quotes are written as CSS
+// escapes, which the browser reads as part of the value. Quoted strings,
+// such as the "Open Sans" in a font-family, are kept as they are.
+func EscapeCSSValue(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c == '"' || c == '\'':
+			if end := stringEnd(value, i); end > 0 {
+				b.WriteString(value[i : end+1])
+				i = end
+				continue
+			}
+			writeCSSEscape(&b, c)
+		case c == ';' || c == '{' || c == '}' || c == '\\' || c == '<' || c == '>' || c < ' ' || c == 0x7f:
+			writeCSSEscape(&b, c)
+		case c == '*' && i > 0 && value[i-1] == '/':
+			writeCSSEscape(&b, c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// stringEnd returns the index of the quote closing the CSS string that
+// starts at value[start], or -1 if the string is not closed on the same
+// line or holds characters that could end the style attribute or element.
+func stringEnd(value string, start int) int {
+	for i := start + 1; i < len(value); i++ {
+		switch c := value[i]; {
+		case c == value[start]:
+			return i
+		case c == '\\':
+			if i+1 < len(value) && value[i+1] >= ' ' {
+				i++
+				continue
+			}
+			return -1
+		case c < ' ' || c == '<' || c == '>':
+			return -1
+		}
+	}
+	return -1
+}
+
+func writeCSSEscape(b *strings.Builder, c byte) {
+	b.WriteString(`\` + strconv.FormatInt(int64(c), 16) + " ")
+}
+
+// formatDeclarations writes the declarations of a single map sorted by
+// property name, since maps have no order of their own.
+func formatDeclarations(decls map[string]string) string {
+	props := make([]string, 0, len(decls))
+	for p := range decls {
+		props = append(props, p)
+	}
+	sort.Strings(props)
+	var b strings.Builder
+	for i, p := range props {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		b.WriteString(p + ": " + decls[p])
+	}
+	return b.String()
+}
+
+// mergeStyles combines the declarations of several style attributes in the
+// order they are written, as the browser reads them. A later declaration
+// overrides an earlier one for the same property and takes its place at the
+// end, so a shorthand in between, such as margin after margin-top, still
+// applies first.
+func mergeStyles(values []string) string {
+	var props, decls []string
+	for _, v := range values {
+		for _, d := range splitDeclarations(v) {
+			prop, value, ok := strings.Cut(d, ":")
+			prop, value = strings.TrimSpace(prop), strings.TrimSpace(value)
+			if !ok || prop == "" || value == "" {
+				continue
+			}
+			for i, p := range props {
+				if p == prop {
+					props = append(props[:i], props[i+1:]...)
+					decls = append(decls[:i], decls[i+1:]...)
+					break
+				}
+			}
+			props = append(props, prop)
+			decls = append(decls, prop+": "+value)
+		}
+	}
+	return strings.Join(decls, "; ")
+}
+
+// splitDeclarations splits a style attribute value at the semicolons that
+// are outside strings and parentheses, such as those of url(...).
+func splitDeclarations(s string) []string {
+	var decls []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"', '\'':
+			if end := stringEnd(s, i); end > 0 {
+				i = end
+			}
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+		case ';':
+			if depth == 0 {
+				decls = append(decls, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(decls, s[start:])
+}
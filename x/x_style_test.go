@@ -0,0 +1,89 @@
+package x
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestStyles(t *testing.T) {
+	tests := []struct {
+		name     string
+		elem     Elem
+		expected string
+	}{
+		{
+			name:     "Sorted declarations",
+			elem:     Div(Styles(StyleMap{"width": Px(120), "color": "red", "--accent": "#0af"})),
+			expected: `<div style="--accent: #0af; color: red; width: 120px"></div>`,
+		},
+		{
+			name:     "Empty values and invalid properties",
+			elem:     Div(Styles(StyleMap{"color": "", "margin": " ", "x;y": "1", "1st": "2", "height": Px(math.NaN()), "opacity": "0.5"})),
+			expected: `<div style="opacity: 0.5"></div>`,
+		},
+		{
+			name:     "Later maps override",
+			elem:     Div(Styles(StyleMap{"color": "red", "top": "0"}, StyleMap{"color": "blue", "top": ""})),
+			expected: `<div style="color: blue"></div>`,
+		},
+		{
+			name:     "Escaped values",
+			elem:     Div(Styles(StyleMap{"color": `red; background: url(x)`, "width": `1px}</style>`})),
+			expected: `<div style="color: red\3b  background: url(x); width: 1px\7d \3c /style\3e "></div>`,
+		},
+		{
+			name:     "Quoted strings",
+			elem:     Div(Styles(StyleMap{"font-family": `"Open Sans", sans-serif`, "content": `"it's; ok"`, "quotes": `"a`})),
+			expected: `<div style="content: &#34;it&#39;s; ok&#34;; font-family: &#34;Open Sans&#34;, sans-serif; quotes: \22 a"></div>`,
+		},
+		{
+			name:     "No declarations left",
+			elem:     Div(Styles(StyleMap{"color": ""})),
+			expected: `<div></div>`,
+		},
+		{
+			name:     "Merged with other style attributes",
+			elem:     Div(StyleAttr("color: red; background: url('a;b.png')"), Att("id", "a"), Styles(StyleMap{"color": "blue", "width": Percent(50)})),
+			expected: `<div style="background: url(&#39;a;b.png&#39;); color: blue; width: 50%" id="a"></div>`,
+		},
+		{
+			name:     "Merged in cascade order",
+			elem:     Div(StyleAttr("margin-top: 5px; margin: 0"), StyleAttr("color: red")),
+			expected: `<div style="margin-top: 5px; margin: 0; color: red"></div>`,
+		},
+		{
+			name:     "Merged overrides move last",
+			elem:     Div(StyleAttr("margin-top: 5px; margin: 0"), Styles(StyleMap{"margin-top": Px(7)})),
+			expected: `<div style="margin: 0; margin-top: 7px"></div>`,
+		},
+		{
+			name:     "Units",
+			elem:     Div(Styles(StyleMap{"margin": Rem(1.5), "padding": Px(int8(-2))})),
+			expected: `<div style="margin: 1.5rem; padding: -2px"></div>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.elem.Render(&buf); err != nil {
+				t.Fatalf("Render() returned an error: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestValidCSSProperty(t *testing.T) {
+	for name, valid := range map[string]bool{
+		"color": true, "-webkit-box-orient": true, "--accent": true, "--my_var": true,
+		"": false, "-": false, "--": false, "1st": false, "-1x": false, "col or": false, "a:b": false,
+	} {
+		if ValidCSSProperty(name) != valid {
+			t.Errorf("ValidCSSProperty(%q): expected %v", name, valid)
+		}
+	}
+}
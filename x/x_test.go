@@ -2,7 +2,11 @@ package x
 
 import (
 	"bytes"
+	"fmt"
+	"math"
+	"net/url"
 	"testing"
+	"time"
 )
 
 func TestElem_Render(t *testing.T) {
@@ -31,11 +35,31 @@ func TestElem_Render(t *testing.T) {
 			elem:     Img(Att("src", "image.png"), Att("alt", "An image")).SelfClose(),
 			expected: `<img src="image.png" alt="An image" />`,
 		},
+		{
+			name:     "Void elements",
+			elem:     P(C("a"), Br(), C("b"), Wbr()),
+			expected: `<p>a<br />b<wbr /></p>`,
+		},
+		{
+			name:     "Option with text",
+			elem:     Select(Att("name", "n"), Option(Att("value", "1"), C("One"))),
+			expected: `<select name="n"><option value="1">One</option></select>`,
+		},
 		{
 			name:     "Nested elements",
 			elem:     Div(Class("container"), Span(C("Nested span"))),
 			expected: `<div class="container"><span>Nested span</span></div>`,
 		},
+		{
+			name:     "Escaped content",
+			elem:     P(C("Tom & Jerry <3")),
+			expected: "<p>Tom &amp; Jerry &lt;3</p>",
+		},
+		{
+			name:     "Fragment with attributes",
+			elem:     Div(Fragment(Class("a"), C("one")), IF(false, Att("id", "x")), Att("title", "t"), C("two")),
+			expected: `<div class="a" title="t">onetwo</div>`,
+		},
 		{
 			name: "Large HTML Document",
 			elem: Html(
@@ -79,6 +103,152 @@ func TestElem_Render(t *testing.T) {
 	}
 }
 
+func TestAttributeHelpers(t *testing.T) {
+	typ := "search"
+	tests := []struct {
+		name     string
+		elem     Elem
+		expected string
+	}{
+		{
+			name:     "Typed input",
+			elem:     Input(InputTypeAttr(InputEmail), Name("email"), Required()),
+			expected: `<input type="email" name="email" required />`,
+		},
+		{
+			name:     "Type from a string variable",
+			elem:     Input(Type(typ), Placeholder("Search")),
+			expected: `<input type="search" placeholder="Search" />`,
+		},
+		{
+			name:     "Link with target and rel tokens",
+			elem:     A(Href("/docs"), Target(TargetBlank), Rel(RelNoopener, RelNoreferrer), C("Docs")),
+			expected: `<a href="/docs" target="_blank" rel="noopener noreferrer">Docs</a>`,
+		},
+		{
+			name:     "Target naming a frame",
+			elem:     A(Href("/docs"), Target(TargetName("preview")), C("Docs")),
+			expected: `<a href="/docs" target="preview">Docs</a>`,
+		},
+		{
+			name:     "Numeric values",
+			elem:     Fragment(Td(ColSpan(2)), Meter(Low(0.25), High(0.75)), Div(TabIndex(-1))),
+			expected: `<td colspan="2"></td><meter low="0.25" high="0.75"></meter><div tabindex="-1"></div>`,
+		},
+		{
+			name:     "Data and aria attributes",
+			elem:     Button(Data("id", "42"), Aria("expanded", "false"), Disabled()),
+			expected: `<button data-id="42" aria-expanded="false" disabled></button>`,
+		},
+		{
+			name:     "Attributes named like elements",
+			elem:     Label(For("q"), TitleAttr("Query"), StyleAttr("color: red")),
+			expected: `<label for="q" title="Query" style="color: red"></label>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.elem.Render(&buf); err != nil {
+				t.Fatalf("Render() returned an error: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestAttributeValues(t *testing.T) {
+	var noURL *url.URL
+	tests := []struct {
+		name     string
+		elem     Elem
+		expected string
+	}{
+		{
+			name:     "Empty value",
+			elem:     Img(Att("src", "a.png"), Att("alt", "")),
+			expected: `<img src="a.png" alt="" />`,
+		},
+		{
+			name:     "Boolean attributes",
+			elem:     Input(AttBool("checked", true), AttBool("disabled", false)),
+			expected: `<input checked />`,
+		},
+		{
+			name:     "Conditional attribute",
+			elem:     Div(AttIf(false, "id", "a"), AttIf(true, "title", "b")),
+			expected: `<div title="b"></div>`,
+		},
+		{
+			name:     "Numbers",
+			elem:     Meter(AttInt("value", 3), AttFloat("low", 0.5), AttFloat("high", 2), AttFloat("max", math.NaN())),
+			expected: `<meter value="3" low="0.5" high="2"></meter>`,
+		},
+		{
+			name: "Times",
+			elem: Fragment(
+				Time(AttTime("datetime", time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC))),
+				Time(AttTime("datetime", time.Date(2024, 5, 1, 9, 30, 0, 250e6, time.FixedZone("", 2*3600)))),
+				Time(AttTime("datetime", time.Time{})),
+			),
+			expected: `<time datetime="2024-05-01T09:30:00Z"></time><time datetime="2024-05-01T09:30:00.25+02:00"></time><time></time>`,
+		},
+		{
+			name:     "Stringers",
+			elem:     Div(AttStringer("data-ttl", 90*time.Second), AttStringer("data-url", noURL), AttStringer("data-nil", nil)),
+			expected: `<div data-ttl="1m30s"></div>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.elem.Render(&buf); err != nil {
+				t.Fatalf("Render() returned an error: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, buf.String())
+			}
+		})
+	}
+
+	if _, ok := Div(AttIf(false, "id", "a")).Attr("id"); ok {
+		t.Error("expected an omitted attribute not to be found")
+	}
+}
+
+func TestC(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{name: "Markup", value: "<b>a & b</b>", expected: "&lt;b&gt;a &amp; b&lt;/b&gt;"},
+		{name: "Entities are escaped once", value: "&amp;", expected: "&amp;amp;"},
+		{name: "Quotes", value: `"it's"`, expected: "&#34;it&#39;s&#34;"},
+		{name: "Formatted values", value: 1.5, expected: "1.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := C(tt.value)
+			if e.Content != fmt.Sprint(tt.value) {
+				t.Errorf("expected Content %q, got %q", fmt.Sprint(tt.value), e.Content)
+			}
+			var buf bytes.Buffer
+			if err := P(e).Render(&buf); err != nil {
+				t.Fatalf("Render() returned an error: %v", err)
+			}
+			if expected := "<p>" + tt.expected + "</p>"; buf.String() != expected {
+				t.Errorf("expected %q, got %q", expected, buf.String())
+			}
+		})
+	}
+}
+
 func TestIF(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -156,3 +326,73 @@ func TestTER(t *testing.T) {
 		})
 	}
 }
+
+func TestElem_GoString(t *testing.T) {
+	tests := []struct {
+		name     string
+		elem     Elem
+		expected string
+	}{
+		{
+			name:     "Leaf element",
+			elem:     P(Class("lead"), C(`Say "hi"`)),
+			expected: `x.P(x.Class("lead"), x.C("Say \"hi\""))`,
+		},
+		{
+			name:     "Function node",
+			elem:     P(RenderFunc(func(*RenderContext) error { return nil })),
+			expected: `x.P(x.RenderFunc(nil /* FuncNode: not reproducible */))`,
+		},
+		{
+			name:     "Generic and self-closing",
+			elem:     E("custom-el", Att("id", "a")).SelfClose(),
+			expected: `x.E("custom-el", x.Att("id", "a")).SelfClose()`,
+		},
+		{
+			name:     "Attribute kinds",
+			elem:     Input(Disabled(), AttIf(false, "id", "a"), Att("value", "")),
+			expected: `x.Input(x.AttBool("disabled", true), x.AttIf(false, "id", "a"), x.Att("value", ""))`,
+		},
+		{
+			name:     "Nested elements",
+			elem:     Div(Class("c"), Img(Att("src", "a.png")), IF(false, Span())),
+			expected: "x.Div(\n\tx.Class(\"c\"),\n\tx.Img(x.Att(\"src\", \"a.png\")),\n\tx.Elem{},\n)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := fmt.Sprintf("%#v", tt.elem)
+			if result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestDump(t *testing.T) {
+	var buf bytes.Buffer
+	err := Dump(&buf, Div(Class("c"), C("hi"), Input().SelfClose()))
+	if err != nil {
+		t.Fatalf("Dump() returned an error: %v", err)
+	}
+	expected := "TagNode div\n  AttributeNode class=\"c\"\n  ContentNode \"hi\"\n  TagNode input (self-closing)\n"
+	if buf.String() != expected {
+		t.Errorf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestConvertNode(t *testing.T) {
+	nodes, err := ParseFragment(`<p>a<br>b</p><my-el></my-el>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, n := range nodes {
+		got = append(got, ConvertNode(n))
+	}
+	expected := []string{"x.P(\nx.C(`a`),\nx.Br(\n),\n\nx.C(`b`),\n),", "x.E(\"my-el\",\n),\n"}
+	if fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
@@ -0,0 +1,311 @@
+package x
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/zulubit/xxhtml/x/spec"
+)
+
+// Issue is a violation of the HTML content model found by Validate.
+type Issue struct {
+	Path    string // Location of the element, such as "html > body > ul > li[2]"
+	Message string // Description of the problem
+}
+
+func (i Issue) String() string {
+	return i.Path + ": " + i.Message
+}
+
+// ValidationError is returned by RenderStrict when the tree is invalid.
+type ValidationError struct {
+	Issues []Issue
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Issues) == 1 {
+		return "invalid HTML: " + e.Issues[0].String()
+	}
+	return fmt.Sprintf("invalid HTML: %s (and %d more issues)", e.Issues[0], len(e.Issues)-1)
+}
+
+// Validate checks the tree against the content models of the HTML standard
+// and returns the issues found, in document order. It reports elements
+// nested where they are not allowed, such as a div inside a p, elements
+// outside their required parent, missing required children, duplicate ids,
+// obsolete elements and attributes an element does not accept. The rules
+// come from package spec.
+//
+// The root element is not checked against a parent, so fragments such as a
+// lone li can be validated. Raw content and nodes produced at render time
+// are not inspected. Custom elements, attributes containing "-" or ":",
+// such as hx-post, and the key attribute used by Diff are accepted.
+func Validate(e Elem) []Issue {
+	v := &validator{ids: make(map[string]string)}
+	var roots []Elem
+	eachContent([]Elem{e}, func(child Elem) bool {
+		if child.Type == TagNode {
+			roots = append(roots, child)
+		}
+		return true
+	})
+	for i, root := range roots {
+		v.visit(root, segment(root, roots, i), position(root, roots, i), "", scope{model: flowContent}, true)
+	}
+	return v.issues
+}
+
+// RenderStrict renders the element only if Validate finds no issues.
+// Otherwise it writes nothing and returns a *ValidationError.
+func (e Elem) RenderStrict(w io.Writer) error {
+	if issues := Validate(e); len(issues) > 0 {
+		return &ValidationError{Issues: issues}
+	}
+	return e.Render(w)
+}
+
+type validator struct {
+	issues []Issue
+	ids    map[string]string // id to the positional path of its first element
+}
+
+// scope is what the content model of the enclosing elements allows.
+type scope struct {
+	model    spec.Content
+	owner    string // element whose content model applies, for messages
+	excludes []exclusion
+}
+
+// exclusion forbids descendants of an element.
+type exclusion struct {
+	categories spec.Category
+	tags       []string
+	by         string
+}
+
+func (v *validator) report(path, format string, args ...interface{}) {
+	v.issues = append(v.issues, Issue{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// visit checks el at path. pos is its path with positional segments only,
+// which tells apart elements sharing an id.
+func (v *validator) visit(el Elem, path, pos, parent string, sc scope, root bool) {
+	tag := strings.ToLower(el.Tag)
+	rule, known := spec.Lookup(tag)
+	custom := strings.Contains(tag, "-")
+	if !known && !custom {
+		v.report(path, "unknown element <%s>", tag)
+	}
+	if rule.Obsolete {
+		v.report(path, "<%s> is obsolete", tag)
+	}
+	cats := spec.Flow | spec.Phrasing
+	if known {
+		cats = categoriesOf(el, rule)
+	}
+
+	if !root && (known || custom) {
+		v.checkPlacement(path, tag, parent, cats, rule, sc)
+	}
+	v.checkAttrs(el, path, pos, tag, rule, known && !custom)
+
+	if known && rule.Content.Kind == spec.Foreign {
+		return
+	}
+
+	// Scope for the children.
+	child := scope{model: rule.Content, owner: tag, excludes: sc.excludes}
+	switch {
+	case !known:
+		child.model = flowContent
+	case rule.Content.Kind == spec.Transparent && !root:
+		child.model = sc.model
+		child.model.Tags = append(append([]string{}, sc.model.Tags...), rule.Content.Tags...)
+		child.owner = sc.owner
+	case rule.Content.Kind == spec.Transparent:
+		child.model = spec.Content{Categories: spec.Flow, Tags: rule.Content.Tags}
+	case tag == "div" && parent == "dl":
+		child.model = spec.Content{Categories: spec.ScriptSupporting, Tags: []string{"dt", "dd"}}
+	}
+	if rule.Content.Exclude != 0 || len(rule.Content.ExcludeTags) > 0 {
+		child.excludes = append(child.excludes[:len(child.excludes):len(child.excludes)], exclusion{
+			categories: rule.Content.Exclude,
+			tags:       rule.Content.ExcludeTags,
+			by:         tag,
+		})
+	}
+
+	var tags []Elem
+	hasText := false
+	eachContent(el.Children, func(c Elem) bool {
+		switch c.Type {
+		case TagNode:
+			tags = append(tags, c)
+		case ContentNode:
+			if strings.TrimSpace(c.Content) != "" {
+				hasText = true
+			}
+		}
+		return true
+	})
+	if hasText && !child.model.AllowsText() {
+		if child.model.Kind == spec.Nothing {
+			v.report(path, "<%s> cannot have content", tag)
+		} else {
+			v.report(path, "text is not allowed inside <%s>", child.owner)
+		}
+	}
+
+	present := make(map[string]bool)
+	for i, c := range tags {
+		present[strings.ToLower(c.Tag)] = true
+		v.visit(c, path+" > "+segment(c, tags, i), pos+" > "+position(c, tags, i), tag, child, false)
+	}
+	for _, req := range rule.Required {
+		if !present[req] {
+			v.report(path, "<%s> must contain a <%s> element", tag, req)
+		}
+	}
+	if tag == "table" && present["tr"] && (present["tbody"] || present["thead"] || present["tfoot"]) {
+		v.report(path, "<tr> directly inside <table> cannot be mixed with <thead>, <tbody> or <tfoot>")
+	}
+}
+
+// checkPlacement reports an element its parent does not allow.
+func (v *validator) checkPlacement(path, tag, parent string, cats spec.Category, rule spec.Element, sc scope) {
+	if len(rule.Parents) > 0 && !contains(rule.Parents, parent) {
+		v.report(path, "<%s> must be a child of %s", tag, tagList(rule.Parents))
+		return
+	}
+	m := sc.model
+	switch m.Kind {
+	case spec.Nothing:
+		v.report(path, "<%s> cannot have content", sc.owner)
+		return
+	case spec.Text:
+		v.report(path, "<%s> is not allowed inside <%s>, which only contains text", tag, sc.owner)
+		return
+	case spec.Categorized:
+		if !m.Allows(tag, cats) && len(rule.Parents) == 0 {
+			v.report(path, "<%s> is not allowed inside <%s>", tag, sc.owner)
+			return
+		}
+	}
+	for _, ex := range sc.excludes {
+		if cats.Has(ex.categories) || contains(ex.tags, tag) {
+			v.report(path, "<%s> is not allowed inside <%s>", tag, ex.by)
+			return
+		}
+	}
+}
+
+// checkAttrs reports duplicate ids and, for known elements, attributes the
+// element does not accept. Duplicate ids are reported at positional paths,
+// since both elements would otherwise be named by the same id.
+func (v *validator) checkAttrs(el Elem, path, pos, tag string, rule spec.Element, checkNames bool) {
+	eachAttr(el.Children, func(a Elem) bool {
+		key := strings.ToLower(a.AttrKey)
+		if key == "id" && a.AttrVal != "" {
+			if first, dup := v.ids[a.AttrVal]; dup {
+				v.report(pos, "duplicate id %q, first used at %s", a.AttrVal, first)
+			} else {
+				v.ids[a.AttrVal] = pos
+			}
+		}
+		if checkNames && !attrAllowed(key, rule) {
+			v.report(path, "attribute %q is not allowed on <%s>", a.AttrKey, tag)
+		}
+		return true
+	})
+}
+
+func attrAllowed(key string, rule spec.Element) bool {
+	if _, ok := rule.Attribute(key); ok || key == "key" || spec.IsEventHandler(key) {
+		return true
+	}
+	// data-*, aria-* and extension attributes such as hx-post
+	return strings.ContainsAny(key, "-:")
+}
+
+// flowContent is the content model assumed for roots and unknown elements.
+var flowContent = spec.Content{Categories: spec.Flow}
+
+// categoriesOf returns the categories of el, including those that depend on
+// its attributes.
+func categoriesOf(el Elem, rule spec.Element) spec.Category {
+	c := rule.Categories
+	switch strings.ToLower(el.Tag) {
+	case "a":
+		if _, ok := el.Attr("href"); ok {
+			c |= spec.Interactive
+		}
+	case "img":
+		if _, ok := el.Attr("usemap"); ok {
+			c |= spec.Interactive
+		}
+	case "audio", "video":
+		if _, ok := el.Attr("controls"); ok {
+			c |= spec.Interactive
+		}
+	case "input":
+		if t, _ := el.Attr("type"); strings.EqualFold(t, "hidden") {
+			c &^= spec.Interactive
+		}
+	}
+	return c
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// segment names the element at index i of siblings in a path. Elements with
+// an id are named by it; others are named by their position.
+func segment(el Elem, siblings []Elem, i int) string {
+	if id, ok := el.Attr("id"); ok && id != "" {
+		return strings.ToLower(el.Tag) + "#" + id
+	}
+	return position(el, siblings, i)
+}
+
+// position names the element at index i of siblings by its tag, followed by
+// its position among siblings with the same tag when there is more than one.
+func position(el Elem, siblings []Elem, i int) string {
+	tag := strings.ToLower(el.Tag)
+	n, pos := 0, 0
+	for j, s := range siblings {
+		if strings.EqualFold(s.Tag, el.Tag) {
+			n++
+			if j == i {
+				pos = n
+			}
+		}
+	}
+	if n > 1 {
+		return tag + "[" + strconv.Itoa(pos) + "]"
+	}
+	return tag
+}
+
+// tagList formats tags as "<a>, <b> or <c>".
+func tagList(tags []string) string {
+	var b strings.Builder
+	for i, t := range tags {
+		switch {
+		case i == 0:
+		case i == len(tags)-1:
+			b.WriteString(" or ")
+		default:
+			b.WriteString(", ")
+		}
+		b.WriteString("<" + t + ">")
+	}
+	return b.String()
+}
@@ -0,0 +1,167 @@
+package x
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		elem     Elem
+		expected []Issue
+	}{
+		{
+			name: "Valid page",
+			elem: Html(Att("lang", "en"),
+				Head(Title(C("Home")), Meta(Att("charset", "utf-8"))),
+				Body(
+					Header(Nav(Ul(Li(A(Att("href", "/"), C("Home")))))),
+					Main(
+						P(C("Hi "), A(Att("href", "/a"), Span(C("there")))),
+						A(Att("href", "/b"), Div(C("Block link"))),
+						Table(E("tbody", Tr(Td(C("1"))))),
+						Form(Label(C("Name "), Input(Att("name", "n"))), Button(C("Send"))),
+						Div(Att("data-x", "1"), Att("hx-post", "/x"), Att("x-on:click", "inc"), Att("key", "k")),
+						E("my-widget", Div()),
+					),
+				),
+			),
+		},
+		{
+			name:     "Div inside p",
+			elem:     P(C("a"), Div(C("b"))),
+			expected: []Issue{{Path: "p > div", Message: "<div> is not allowed inside <p>"}},
+		},
+		{
+			name:     "Div inside a inside p",
+			elem:     P(A(Att("href", "/"), Div())),
+			expected: []Issue{{Path: "p > a > div", Message: "<div> is not allowed inside <p>"}},
+		},
+		{
+			name:     "Li outside a list",
+			elem:     Div(Li(C("a")), Li(C("b"))),
+			expected: []Issue{{Path: "div > li[1]", Message: "<li> must be a child of <ol>, <ul> or <menu>"}, {Path: "div > li[2]", Message: "<li> must be a child of <ol>, <ul> or <menu>"}},
+		},
+		{
+			name:     "Text in a list",
+			elem:     Ul(C("oops"), Li()),
+			expected: []Issue{{Path: "ul", Message: "text is not allowed inside <ul>"}},
+		},
+		{
+			name:     "Interactive content inside a link",
+			elem:     A(Att("href", "/"), Button(C("x"))),
+			expected: []Issue{{Path: "a > button", Message: "<button> is not allowed inside <a>"}},
+		},
+		{
+			name:     "Nested forms",
+			elem:     Form(Div(Form())),
+			expected: []Issue{{Path: "form > div > form", Message: "<form> is not allowed inside <form>"}},
+		},
+		{
+			name:     "Rows mixed with tbody",
+			elem:     Table(Tr(Td()), E("tbody", Tr(Td()))),
+			expected: []Issue{{Path: "table", Message: "<tr> directly inside <table> cannot be mixed with <thead>, <tbody> or <tfoot>"}},
+		},
+		{
+			name:     "Cell outside a row",
+			elem:     Table(Td()),
+			expected: []Issue{{Path: "table > td", Message: "<td> must be a child of <tr>"}},
+		},
+		{
+			name: "Missing required children",
+			elem: Html(Head(), Body()),
+			expected: []Issue{
+				{Path: "html > head", Message: "<head> must contain a <title> element"},
+			},
+		},
+		{
+			name: "Duplicate ids",
+			elem: Div(Div(Att("id", "a")), Span(Att("id", "a"))),
+			expected: []Issue{
+				{Path: "div > span", Message: `duplicate id "a", first used at div > div`},
+			},
+		},
+		{
+			name: "Duplicate ids on siblings with the same tag",
+			elem: Div(Span(Att("id", "a")), Span(Att("id", "a"))),
+			expected: []Issue{
+				{Path: "div > span[2]", Message: `duplicate id "a", first used at div > span[1]`},
+			},
+		},
+		{
+			name: "Attributes not allowed",
+			elem: Div(Att("href", "/"), Img(Att("alt", ""), Att("colspan", "2"))),
+			expected: []Issue{
+				{Path: "div", Message: `attribute "href" is not allowed on <div>`},
+				{Path: "div > img", Message: `attribute "colspan" is not allowed on <img>`},
+			},
+		},
+		{
+			name:     "Content in a void element",
+			elem:     E("br", C("x")),
+			expected: []Issue{{Path: "br", Message: "<br> cannot have content"}},
+		},
+		{
+			name:     "Element in a text-only element",
+			elem:     Title(E("b")),
+			expected: []Issue{{Path: "title > b", Message: "<b> is not allowed inside <title>, which only contains text"}},
+		},
+		{
+			name:     "Unknown element",
+			elem:     E("blink", C("!")),
+			expected: []Issue{{Path: "blink", Message: "unknown element <blink>"}},
+		},
+		{
+			name:     "Obsolete element",
+			elem:     E("object", Att("data", "movie.swf"), Param(Att("name", "autoplay"), Att("value", "true"))),
+			expected: []Issue{{Path: "object > param", Message: "<param> is obsolete"}},
+		},
+		{
+			name: "Fragment roots and dl groups",
+			elem: Fragment(
+				E("dl", Div(E("dt", C("a")), E("dd", C("b")))),
+				Li(C("standalone")),
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.elem)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRenderStrict(t *testing.T) {
+	var buf bytes.Buffer
+	err := P(Div()).RenderStrict(&buf)
+	var verr *ValidationError
+	if !errors.As(err, &verr) || len(verr.Issues) != 1 {
+		t.Fatalf("expected a ValidationError with one issue, got %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing to be written, got %q", buf.String())
+	}
+
+	if err := P(Span()).RenderStrict(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "<p><span></span></p>" {
+		t.Errorf("expected %q, got %q", "<p><span></span></p>", buf.String())
+	}
+
+	h := Handler(func(r *http.Request) (Elem, error) { return Ul(Div()), nil }).Strict()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
+	}
+}